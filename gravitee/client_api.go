@@ -0,0 +1,273 @@
+// client_api.go
+package gravitee
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// API represents a Gravitee V4 API
+type API struct {
+	ID                string          `json:"id,omitempty"`
+	Name              string          `json:"name"`
+	Description       string          `json:"description"`
+	APIVersion        string          `json:"apiVersion"`
+	DefinitionVersion string          `json:"definitionVersion"`
+	Type              string          `json:"type"`
+	Listeners         []Listener      `json:"listeners"`
+	EndpointGroups    []EndpointGroup `json:"endpointGroups"`
+	Analytics         *Analytics      `json:"analytics,omitempty"`
+	Flows             []Flow          `json:"flows"`
+	Categories        []string        `json:"categories"`
+	Tags              []string        `json:"tags"`
+	State             string          `json:"state,omitempty"`
+	DeploymentState   string          `json:"deploymentState,omitempty"`
+	WorkflowState     string          `json:"workflowState,omitempty"`
+	LifecycleState    string          `json:"lifecycleState,omitempty"`
+	Visibility        string          `json:"visibility,omitempty"`
+	CreatedAt         string          `json:"createdAt,omitempty"`
+	UpdatedAt         string          `json:"updatedAt,omitempty"`
+	DeployedAt        string          `json:"deployedAt,omitempty"`
+}
+
+// Listener represents the way an API is exposed by the gateway
+type Listener struct {
+	Type        string         `json:"type"`
+	Paths       []ListenerPath `json:"paths,omitempty"`
+	Entrypoints []Entrypoint   `json:"entrypoints"`
+}
+
+// ListenerPath represents a context path of an HTTP listener
+type ListenerPath struct {
+	Path string `json:"path"`
+}
+
+// Entrypoint represents an entrypoint of a listener
+type Entrypoint struct {
+	Type          string                 `json:"type"`
+	Configuration map[string]interface{} `json:"configuration,omitempty"`
+}
+
+// EndpointGroup represents a group of backend endpoints sharing a configuration
+type EndpointGroup struct {
+	Name                string                 `json:"name"`
+	Type                string                 `json:"type"`
+	Endpoints           []Endpoint             `json:"endpoints"`
+	SharedConfiguration map[string]interface{} `json:"sharedConfiguration,omitempty"`
+}
+
+// Endpoint represents a backend endpoint
+type Endpoint struct {
+	Name                        string                 `json:"name"`
+	Type                        string                 `json:"type"`
+	Weight                      int                    `json:"weight,omitempty"`
+	InheritConfiguration        bool                   `json:"inheritConfiguration"`
+	Tenants                     []string               `json:"tenants,omitempty"`
+	Configuration               map[string]interface{} `json:"configuration,omitempty"`
+	SharedConfigurationOverride map[string]interface{} `json:"sharedConfigurationOverride,omitempty"`
+}
+
+// Analytics represents the analytics and logging settings of an API
+type Analytics struct {
+	Enabled bool              `json:"enabled"`
+	Logging *AnalyticsLogging `json:"logging,omitempty"`
+}
+
+// AnalyticsLogging represents what the gateway logs for each call
+type AnalyticsLogging struct {
+	Mode    *LoggingMode    `json:"mode,omitempty"`
+	Phase   *LoggingPhase   `json:"phase,omitempty"`
+	Content *LoggingContent `json:"content,omitempty"`
+}
+
+// LoggingMode represents the sides of the gateway that are logged
+type LoggingMode struct {
+	Entrypoint bool `json:"entrypoint"`
+	Endpoint   bool `json:"endpoint"`
+}
+
+// LoggingPhase represents the phases of a call that are logged
+type LoggingPhase struct {
+	Request  bool `json:"request"`
+	Response bool `json:"response"`
+}
+
+// LoggingContent represents the parts of a call that are logged
+type LoggingContent struct {
+	Headers bool `json:"headers"`
+	Payload bool `json:"payload"`
+}
+
+// Flow represents a set of policies applied to the calls matching its selectors
+type Flow struct {
+	Name      string         `json:"name,omitempty"`
+	Enabled   bool           `json:"enabled"`
+	Selectors []FlowSelector `json:"selectors"`
+	Request   []FlowStep     `json:"request,omitempty"`
+	Response  []FlowStep     `json:"response,omitempty"`
+	Subscribe []FlowStep     `json:"subscribe,omitempty"`
+	Publish   []FlowStep     `json:"publish,omitempty"`
+}
+
+// FlowSelector represents a condition on the calls a flow applies to
+type FlowSelector struct {
+	Type            string   `json:"type"`
+	Path            string   `json:"path,omitempty"`
+	PathOperator    string   `json:"pathOperator,omitempty"`
+	Methods         []string `json:"methods,omitempty"`
+	Channel         string   `json:"channel,omitempty"`
+	ChannelOperator string   `json:"channelOperator,omitempty"`
+	Operations      []string `json:"operations,omitempty"`
+	Condition       string   `json:"condition,omitempty"`
+}
+
+// FlowStep represents a policy executed by a flow
+type FlowStep struct {
+	Name          string                 `json:"name,omitempty"`
+	Description   string                 `json:"description,omitempty"`
+	Enabled       bool                   `json:"enabled"`
+	Policy        string                 `json:"policy"`
+	Configuration map[string]interface{} `json:"configuration,omitempty"`
+}
+
+// Create an API
+func (c *Client) CreateAPI(api *API) (*API, error) {
+	body, err := json.Marshal(api)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/v2/environments/DEFAULT/apis", c.ManagementURL), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var createdAPI API
+	if err := json.NewDecoder(resp.Body).Decode(&createdAPI); err != nil {
+		return nil, err
+	}
+
+	return &createdAPI, nil
+}
+
+// Get an API by ID
+func (c *Client) GetAPI(apiID string) (*API, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/v2/environments/DEFAULT/apis/%s", c.ManagementURL, apiID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var api API
+	if err := json.NewDecoder(resp.Body).Decode(&api); err != nil {
+		return nil, err
+	}
+
+	return &api, nil
+}
+
+// Update an API
+func (c *Client) UpdateAPI(api *API) error {
+	body, err := json.Marshal(api)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/management/v2/environments/DEFAULT/apis/%s", c.ManagementURL, api.ID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Delete an API, closing its plans
+func (c *Client) DeleteAPI(apiID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/management/v2/environments/DEFAULT/apis/%s?closePlans=true", c.ManagementURL, apiID), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Start an API on the gateways
+func (c *Client) StartAPI(apiID string) error {
+	return c.apiAction(apiID, "_start")
+}
+
+// Stop an API on the gateways
+func (c *Client) StopAPI(apiID string) error {
+	return c.apiAction(apiID, "_stop")
+}
+
+func (c *Client) apiAction(apiID string, action string) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/v2/environments/DEFAULT/apis/%s/%s", c.ManagementURL, apiID, action), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
\ No newline at end of file
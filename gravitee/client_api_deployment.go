@@ -0,0 +1,41 @@
+// client_api_deployment.go
+package gravitee
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// APIDeployment is the request deploying the current definition of an API to the gateways
+type APIDeployment struct {
+	DeploymentLabel string `json:"deploymentLabel,omitempty"`
+}
+
+// Deploy the current definition of an API to the gateways
+func (c *Client) DeployAPI(apiID string, deploymentLabel string) error {
+	body, err := json.Marshal(&APIDeployment{DeploymentLabel: deploymentLabel})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/v2/environments/DEFAULT/apis/%s/deployments", c.ManagementURL, apiID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,260 @@
+// client_api_key.go
+package gravitee
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// APIKey represents a Gravitee API key
+type APIKey struct {
+	ID        string `json:"id,omitempty"`
+	Key       string `json:"key,omitempty"`
+	Revoked   bool   `json:"revoked,omitempty"`
+	Expired   bool   `json:"expired,omitempty"`
+	Paused    bool   `json:"paused,omitempty"`
+	ExpireAt  string `json:"expireAt,omitempty"`
+	RevokedAt string `json:"revokedAt,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+}
+
+// APIKeyList represents a page of API keys
+type APIKeyList struct {
+	Data []APIKey `json:"data"`
+}
+
+// applicationAPIKey is the representation of an API key on the application endpoints
+type applicationAPIKey struct {
+	ID        string `json:"id"`
+	Key       string `json:"key"`
+	Revoked   bool   `json:"revoked"`
+	Expired   bool   `json:"expired"`
+	Paused    bool   `json:"paused"`
+	ExpireAt  int64  `json:"expire_at,omitempty"`
+	RevokedAt int64  `json:"revoked_at,omitempty"`
+	CreatedAt int64  `json:"created_at,omitempty"`
+}
+
+func (k applicationAPIKey) toAPIKey() APIKey {
+	return APIKey{
+		ID:        k.ID,
+		Key:       k.Key,
+		Revoked:   k.Revoked,
+		Expired:   k.Expired,
+		Paused:    k.Paused,
+		ExpireAt:  formatTimestamp(k.ExpireAt),
+		RevokedAt: formatTimestamp(k.RevokedAt),
+		CreatedAt: formatTimestamp(k.CreatedAt),
+	}
+}
+
+// formatTimestamp converts a timestamp in milliseconds to an RFC3339 date
+func formatTimestamp(timestamp int64) string {
+	if timestamp == 0 {
+		return ""
+	}
+
+	return time.UnixMilli(timestamp).UTC().Format(time.RFC3339)
+}
+
+// List the API keys of a Subscription
+func (c *Client) ListSubscriptionAPIKeys(apiID string, subscriptionID string) ([]APIKey, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/v2/environments/DEFAULT/apis/%s/subscriptions/%s/api-keys?perPage=100", c.ManagementURL, apiID, subscriptionID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var apiKeys APIKeyList
+	if err := json.NewDecoder(resp.Body).Decode(&apiKeys); err != nil {
+		return nil, err
+	}
+
+	return apiKeys.Data, nil
+}
+
+// Renew the API key of a Subscription, optionally with a custom key value
+func (c *Client) RenewSubscriptionAPIKey(apiID string, subscriptionID string, customAPIKey string) (*APIKey, error) {
+	body, err := json.Marshal(map[string]string{
+		"customApiKey": customAPIKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/v2/environments/DEFAULT/apis/%s/subscriptions/%s/api-keys/_renew", c.ManagementURL, apiID, subscriptionID), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var apiKey APIKey
+	if err := json.NewDecoder(resp.Body).Decode(&apiKey); err != nil {
+		return nil, err
+	}
+
+	return &apiKey, nil
+}
+
+// Update the expiration date of a Subscription API key
+func (c *Client) UpdateSubscriptionAPIKey(apiID string, subscriptionID string, apiKey *APIKey) error {
+	body, err := json.Marshal(map[string]string{
+		"expireAt": apiKey.ExpireAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/management/v2/environments/DEFAULT/apis/%s/subscriptions/%s/api-keys/%s", c.ManagementURL, apiID, subscriptionID, apiKey.ID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Revoke a Subscription API key
+func (c *Client) RevokeSubscriptionAPIKey(apiID string, subscriptionID string, apiKeyID string) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/v2/environments/DEFAULT/apis/%s/subscriptions/%s/api-keys/%s/_revoke", c.ManagementURL, apiID, subscriptionID, apiKeyID), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// List the API keys of an Application using the SHARED API key mode
+func (c *Client) ListApplicationAPIKeys(applicationID string) ([]APIKey, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/applications/%s/apikeys", c.ManagementURL, applicationID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var applicationAPIKeys []applicationAPIKey
+	if err := json.NewDecoder(resp.Body).Decode(&applicationAPIKeys); err != nil {
+		return nil, err
+	}
+
+	apiKeys := make([]APIKey, len(applicationAPIKeys))
+	for i, apiKey := range applicationAPIKeys {
+		apiKeys[i] = apiKey.toAPIKey()
+	}
+
+	return apiKeys, nil
+}
+
+// Renew the shared API key of an Application
+func (c *Client) RenewApplicationAPIKey(applicationID string) (*APIKey, error) {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/applications/%s/apikeys/_renew", c.ManagementURL, applicationID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var renewedKey applicationAPIKey
+	if err := json.NewDecoder(resp.Body).Decode(&renewedKey); err != nil {
+		return nil, err
+	}
+
+	apiKey := renewedKey.toAPIKey()
+	return &apiKey, nil
+}
+
+// Revoke a shared API key of an Application
+func (c *Client) RevokeApplicationAPIKey(applicationID string, apiKeyID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/applications/%s/apikeys/%s", c.ManagementURL, applicationID, apiKeyID), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,55 @@
+// client_api_review.go
+package gravitee
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// APIReview is the message sent with a review request or a review decision
+type APIReview struct {
+	Message string `json:"message"`
+}
+
+// Ask for a review of an API
+func (c *Client) AskForAPIReview(apiID string, message string) error {
+	return c.apiReviewAction(apiID, "_ask", message)
+}
+
+// Accept the review of an API
+func (c *Client) AcceptAPIReview(apiID string, message string) error {
+	return c.apiReviewAction(apiID, "_accept", message)
+}
+
+// Reject the review of an API, requesting changes
+func (c *Client) RejectAPIReview(apiID string, message string) error {
+	return c.apiReviewAction(apiID, "_reject", message)
+}
+
+func (c *Client) apiReviewAction(apiID string, action string, message string) error {
+	body, err := json.Marshal(&APIReview{Message: message})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/apis/%s/reviews/%s", c.ManagementURL, apiID, action), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
\ No newline at end of file
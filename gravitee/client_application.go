@@ -0,0 +1,167 @@
+// client_application.go
+package gravitee
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Application represents a Gravitee application
+type Application struct {
+	ID          string               `json:"id,omitempty"`
+	Name        string               `json:"name"`
+	Description string               `json:"description"`
+	Domain      string               `json:"domain,omitempty"`
+	Picture     string               `json:"picture,omitempty"`
+	Groups      []string             `json:"groups,omitempty"`
+	Type        string               `json:"type,omitempty"`
+	Status      string               `json:"status,omitempty"`
+	Settings    *ApplicationSettings `json:"settings,omitempty"`
+	APIKeyMode  string               `json:"api_key_mode,omitempty"`
+	CreatedAt   int64                `json:"created_at,omitempty"`
+	UpdatedAt   int64                `json:"updated_at,omitempty"`
+}
+
+// ApplicationSettings represents the settings of an application
+type ApplicationSettings struct {
+	App   *SimpleApplicationSettings `json:"app,omitempty"`
+	OAuth *OAuthClientSettings       `json:"oauth,omitempty"`
+	TLS   *ApplicationTLSSettings    `json:"tls,omitempty"`
+}
+
+// SimpleApplicationSettings represents the settings of a SIMPLE application
+type SimpleApplicationSettings struct {
+	Type     string `json:"type,omitempty"`
+	ClientID string `json:"client_id,omitempty"`
+}
+
+// ApplicationTLSSettings represents the TLS settings used to identify an application on mTLS plans
+type ApplicationTLSSettings struct {
+	ClientCertificate string `json:"client_certificate,omitempty"`
+}
+
+// OAuthClientSettings represents the OAuth client settings of a DCR-backed application
+type OAuthClientSettings struct {
+	ApplicationType string   `json:"application_type,omitempty"`
+	ClientID        string   `json:"client_id,omitempty"`
+	ClientSecret    string   `json:"client_secret,omitempty"`
+	GrantTypes      []string `json:"grant_types"`
+	RedirectURIs    []string `json:"redirect_uris"`
+}
+
+// Create an Application
+func (c *Client) CreateApplication(application *Application) (*Application, error) {
+	body, err := json.Marshal(application)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/applications", c.ManagementURL), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var createdApplication Application
+	if err := json.NewDecoder(resp.Body).Decode(&createdApplication); err != nil {
+		return nil, err
+	}
+
+	return &createdApplication, nil
+}
+
+// Get an Application by ID
+func (c *Client) GetApplication(applicationID string) (*Application, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/applications/%s", c.ManagementURL, applicationID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var application Application
+	if err := json.NewDecoder(resp.Body).Decode(&application); err != nil {
+		return nil, err
+	}
+
+	// Archived applications are kept by Gravitee but no longer usable
+	if application.Status == "ARCHIVED" {
+		return nil, nil
+	}
+
+	return &application, nil
+}
+
+// Update an Application
+func (c *Client) UpdateApplication(application *Application) error {
+	body, err := json.Marshal(application)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/applications/%s", c.ManagementURL, application.ID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Delete an Application
+func (c *Client) DeleteApplication(applicationID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/applications/%s", c.ManagementURL, applicationID), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,159 @@
+// client_category.go
+package gravitee
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Category represents a Developer Portal category of APIs
+type Category struct {
+	ID           string `json:"id,omitempty"`
+	Key          string `json:"key,omitempty"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Picture      string `json:"picture,omitempty"`
+	Background   string `json:"background,omitempty"`
+	HighlightAPI string `json:"highlightApi,omitempty"`
+	Order        int    `json:"order"`
+	Hidden       bool   `json:"hidden"`
+	TotalAPIs    int    `json:"totalApis,omitempty"`
+}
+
+// Create a Category
+func (c *Client) CreateCategory(category *Category) (*Category, error) {
+	body, err := json.Marshal(category)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/categories", c.ManagementURL), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var createdCategory Category
+	if err := json.NewDecoder(resp.Body).Decode(&createdCategory); err != nil {
+		return nil, err
+	}
+
+	return &createdCategory, nil
+}
+
+// Get a Category by ID or key
+func (c *Client) GetCategory(categoryID string) (*Category, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/categories/%s", c.ManagementURL, categoryID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var category Category
+	if err := json.NewDecoder(resp.Body).Decode(&category); err != nil {
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+// List all Categories
+func (c *Client) ListCategories() ([]Category, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/categories", c.ManagementURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var categories []Category
+	if err := json.NewDecoder(resp.Body).Decode(&categories); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// Update a Category
+func (c *Client) UpdateCategory(category *Category) error {
+	body, err := json.Marshal(category)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/categories/%s", c.ManagementURL, category.ID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Delete a Category
+func (c *Client) DeleteCategory(categoryID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/categories/%s", c.ManagementURL, categoryID), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
\ No newline at end of file
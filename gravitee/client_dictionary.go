@@ -0,0 +1,202 @@
+// client_dictionary.go
+package gravitee
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Dictionary represents a set of properties available to gateways through EL expressions
+type Dictionary struct {
+	ID          string              `json:"id,omitempty"`
+	Key         string              `json:"key,omitempty"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Type        string              `json:"type"`
+	Properties  map[string]string   `json:"properties,omitempty"`
+	Provider    *DictionaryProvider `json:"provider,omitempty"`
+	Trigger     *DictionaryTrigger  `json:"trigger,omitempty"`
+	State       string              `json:"state,omitempty"`
+	UpdatedAt   int64               `json:"updated_at,omitempty"`
+	DeployedAt  int64               `json:"deployed_at,omitempty"`
+}
+
+// DictionaryProvider fetches the properties of a dynamic dictionary
+type DictionaryProvider struct {
+	Type          string                               `json:"type"`
+	Configuration *DictionaryHTTPProviderConfiguration `json:"configuration"`
+}
+
+// DictionaryHTTPProviderConfiguration polls an HTTP endpoint and transforms the response with JOLT
+type DictionaryHTTPProviderConfiguration struct {
+	URL            string                     `json:"url"`
+	Method         string                     `json:"method"`
+	Headers        []DictionaryProviderHeader `json:"headers"`
+	Body           string                     `json:"body,omitempty"`
+	Specification  string                     `json:"specification"`
+	UseSystemProxy bool                       `json:"useSystemProxy"`
+}
+
+// DictionaryProviderHeader is an HTTP header sent by the provider
+type DictionaryProviderHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// DictionaryTrigger is the polling schedule of a dynamic dictionary
+type DictionaryTrigger struct {
+	Rate int64  `json:"rate"`
+	Unit string `json:"unit"`
+}
+
+// Create a Dictionary
+func (c *Client) CreateDictionary(dictionary *Dictionary) (*Dictionary, error) {
+	body, err := json.Marshal(dictionary)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/dictionaries", c.ManagementURL), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var createdDictionary Dictionary
+	if err := json.NewDecoder(resp.Body).Decode(&createdDictionary); err != nil {
+		return nil, err
+	}
+
+	return &createdDictionary, nil
+}
+
+// Get a Dictionary by ID
+func (c *Client) GetDictionary(dictionaryID string) (*Dictionary, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/dictionaries/%s", c.ManagementURL, dictionaryID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var dictionary Dictionary
+	if err := json.NewDecoder(resp.Body).Decode(&dictionary); err != nil {
+		return nil, err
+	}
+
+	return &dictionary, nil
+}
+
+// Update a Dictionary
+func (c *Client) UpdateDictionary(dictionary *Dictionary) error {
+	body, err := json.Marshal(dictionary)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/dictionaries/%s", c.ManagementURL, dictionary.ID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Delete a Dictionary
+func (c *Client) DeleteDictionary(dictionaryID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/dictionaries/%s", c.ManagementURL, dictionaryID), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Deploy a Dictionary to the gateways
+func (c *Client) DeployDictionary(dictionaryID string) error {
+	return c.dictionaryAction(dictionaryID, "_deploy")
+}
+
+// Undeploy a Dictionary from the gateways
+func (c *Client) UndeployDictionary(dictionaryID string) error {
+	return c.dictionaryAction(dictionaryID, "_undeploy")
+}
+
+// Start polling the provider of a dynamic Dictionary
+func (c *Client) StartDictionary(dictionaryID string) error {
+	return c.dictionaryAction(dictionaryID, "_start")
+}
+
+// Stop polling the provider of a dynamic Dictionary
+func (c *Client) StopDictionary(dictionaryID string) error {
+	return c.dictionaryAction(dictionaryID, "_stop")
+}
+
+func (c *Client) dictionaryAction(dictionaryID string, action string) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/dictionaries/%s/%s", c.ManagementURL, dictionaryID, action), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
\ No newline at end of file
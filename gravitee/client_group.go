@@ -0,0 +1,249 @@
+// client_group.go
+package gravitee
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Group represents a Gravitee group
+type Group struct {
+	ID                             string            `json:"id,omitempty"`
+	Name                           string            `json:"name"`
+	EventRules                     []GroupEventRule  `json:"event_rules"`
+	Roles                          map[string]string `json:"roles,omitempty"`
+	MaxInvitation                  *int              `json:"max_invitation,omitempty"`
+	LockAPIRole                    bool              `json:"lock_api_role"`
+	LockApplicationRole            bool              `json:"lock_application_role"`
+	SystemInvitation               bool              `json:"system_invitation"`
+	EmailInvitation                bool              `json:"email_invitation"`
+	DisableMembershipNotifications bool              `json:"disable_membership_notifications"`
+}
+
+// GroupEventRule represents an event adding the group to new APIs or applications
+type GroupEventRule struct {
+	Event string `json:"event"`
+}
+
+// GroupMember represents the roles of a user in a group
+type GroupMember struct {
+	ID          string            `json:"id"`
+	DisplayName string            `json:"displayName,omitempty"`
+	Roles       map[string]string `json:"roles"`
+}
+
+// Create a Group
+func (c *Client) CreateGroup(group *Group) (*Group, error) {
+	body, err := json.Marshal(group)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/groups", c.ManagementURL), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var createdGroup Group
+	if err := json.NewDecoder(resp.Body).Decode(&createdGroup); err != nil {
+		return nil, err
+	}
+
+	return &createdGroup, nil
+}
+
+// Get a Group by ID
+func (c *Client) GetGroup(groupID string) (*Group, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/groups/%s", c.ManagementURL, groupID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var group Group
+	if err := json.NewDecoder(resp.Body).Decode(&group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// List all Groups
+func (c *Client) ListGroups() ([]Group, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/groups", c.ManagementURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var groups []Group
+	if err := json.NewDecoder(resp.Body).Decode(&groups); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// Update a Group
+func (c *Client) UpdateGroup(group *Group) error {
+	body, err := json.Marshal(group)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/groups/%s", c.ManagementURL, group.ID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Delete a Group
+func (c *Client) DeleteGroup(groupID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/groups/%s", c.ManagementURL, groupID), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// List the members of a Group
+func (c *Client) ListGroupMembers(groupID string) ([]GroupMember, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/groups/%s/members", c.ManagementURL, groupID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var members []GroupMember
+	if err := json.NewDecoder(resp.Body).Decode(&members); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// Add a member to a Group, or update the roles of an existing member
+func (c *Client) SaveGroupMember(groupID string, member *GroupMember) error {
+	body, err := json.Marshal([]*GroupMember{member})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/groups/%s/members", c.ManagementURL, groupID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Remove a member from a Group
+func (c *Client) DeleteGroupMember(groupID string, userID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/configuration/groups/%s/members/%s", c.ManagementURL, groupID, userID), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,129 @@
+// client_member.go
+package gravitee
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Member represents the role of a user or a group on an API or an application
+type Member struct {
+	ID          string `json:"id"`
+	Reference   string `json:"reference,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	Role        string `json:"role"`
+	// Type is USER or GROUP, members without a type are users
+	Type string `json:"type,omitempty"`
+}
+
+// List the members of a reference, referenceType being "apis" or "applications"
+func (c *Client) ListMembers(referenceType string, referenceID string) ([]Member, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/%s/%s/members", c.ManagementURL, referenceType, referenceID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var members []Member
+	if err := json.NewDecoder(resp.Body).Decode(&members); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// Add a member to a reference, or update the role of an existing member
+func (c *Client) SaveMember(referenceType string, referenceID string, member *Member) error {
+	body, err := json.Marshal(member)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/%s/%s/members", c.ManagementURL, referenceType, referenceID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Remove a member from a reference, memberType being "USER" or "GROUP"
+func (c *Client) DeleteMember(referenceType string, referenceID string, memberType string, memberID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/%s/%s/members?%s=%s", c.ManagementURL, referenceType, referenceID, strings.ToLower(memberType), url.QueryEscape(memberID)), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Transfer the primary ownership of a reference to a user, the previous owner getting the given role
+func (c *Client) TransferOwnership(referenceType string, referenceID string, userID string, previousOwnerRole string) error {
+	body, err := json.Marshal(Member{
+		ID:   userID,
+		Role: previousOwnerRole,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/%s/%s/members/transfer_ownership", c.ManagementURL, referenceType, referenceID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
\ No newline at end of file
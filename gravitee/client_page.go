@@ -0,0 +1,259 @@
+// client_page.go
+package gravitee
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Page represents a documentation page of an API in the developer portal
+type Page struct {
+	ID                     string              `json:"id,omitempty"`
+	Name                   string              `json:"name"`
+	Type                   string              `json:"type"`
+	Content                string              `json:"content,omitempty"`
+	ParentID               string              `json:"parentId,omitempty"`
+	Order                  int                 `json:"order"`
+	Published              bool                `json:"published"`
+	Visibility             string              `json:"visibility,omitempty"`
+	Homepage               bool                `json:"homepage"`
+	Configuration          map[string]string   `json:"configuration,omitempty"`
+	AccessControls         []PageAccessControl `json:"accessControls"`
+	ExcludedAccessControls bool                `json:"excludedAccessControls"`
+	Source                 *PageSource         `json:"source,omitempty"`
+}
+
+// PageSource is the fetcher Gravitee uses to retrieve the content of a page
+type PageSource struct {
+	Type          string                 `json:"type"`
+	Configuration map[string]interface{} `json:"configuration"`
+}
+
+// PagesImport imports a tree of pages from a fetcher
+type PagesImport struct {
+	Type                   string              `json:"type"`
+	Published              bool                `json:"published"`
+	Visibility             string              `json:"visibility,omitempty"`
+	Source                 *PageSource         `json:"source"`
+	Configuration          map[string]string   `json:"configuration"`
+	AccessControls         []PageAccessControl `json:"accessControls"`
+	ExcludedAccessControls bool                `json:"excludedAccessControls"`
+}
+
+// PageAccessControl restricts a page to a group or a role
+type PageAccessControl struct {
+	ReferenceID   string `json:"referenceId"`
+	ReferenceType string `json:"referenceType"`
+}
+
+// pagesURL returns the URL of the pages of an API, or of the portal pages of the environment when apiID is empty
+func (c *Client) pagesURL(apiID string) string {
+	if apiID == "" {
+		return fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/portal/pages", c.ManagementURL)
+	}
+
+	return fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/apis/%s/pages", c.ManagementURL, apiID)
+}
+
+// Create a Page for an API, or a portal Page when apiID is empty
+func (c *Client) CreatePage(apiID string, page *Page) (*Page, error) {
+	body, err := json.Marshal(page)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.pagesURL(apiID), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var createdPage Page
+	if err := json.NewDecoder(resp.Body).Decode(&createdPage); err != nil {
+		return nil, err
+	}
+
+	return &createdPage, nil
+}
+
+// Get a Page by ID
+func (c *Client) GetPage(apiID string, pageID string) (*Page, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/%s", c.pagesURL(apiID), pageID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var page Page
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
+
+// Update a Page
+func (c *Client) UpdatePage(apiID string, page *Page) error {
+	body, err := json.Marshal(page)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/%s", c.pagesURL(apiID), page.ID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Delete a Page
+func (c *Client) DeletePage(apiID string, pageID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/%s", c.pagesURL(apiID), pageID), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Fetch the content of a Page from its source
+func (c *Client) FetchPage(apiID string, pageID string) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/_fetch", c.pagesURL(apiID), pageID), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Import the Pages of an API from a fetcher, or refresh them when they were already imported
+func (c *Client) ImportPages(apiID string, pagesImport *PagesImport, refresh bool) ([]Page, error) {
+	body, err := json.Marshal(pagesImport)
+	if err != nil {
+		return nil, err
+	}
+
+	method := "POST"
+	if refresh {
+		method = "PUT"
+	}
+
+	req, err := http.NewRequest(method, fmt.Sprintf("%s/_import", c.pagesURL(apiID)), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var pages []Page
+	if err := json.NewDecoder(resp.Body).Decode(&pages); err != nil {
+		return nil, err
+	}
+
+	return pages, nil
+}
+
+// List the Pages of a type for an API, or the portal Pages when apiID is empty. All the Pages
+// are listed when pageType is empty
+func (c *Client) ListPages(apiID string, pageType string) ([]Page, error) {
+	pagesURL := c.pagesURL(apiID)
+	if pageType != "" {
+		pagesURL = fmt.Sprintf("%s?type=%s", pagesURL, url.QueryEscape(pageType))
+	}
+
+	req, err := http.NewRequest("GET", pagesURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var pages []Page
+	if err := json.NewDecoder(resp.Body).Decode(&pages); err != nil {
+		return nil, err
+	}
+
+	return pages, nil
+}
\ No newline at end of file
@@ -19,6 +19,7 @@ type Plan struct {
 	Characteristics   []string    `json:"characteristics,omitempty"`
 	Validation        string      `json:"validation,omitempty"`
 	Status            string      `json:"status,omitempty"`
+	Tags              []string    `json:"tags"`
 }
 
 // Security represents a plan's security configuration
@@ -0,0 +1,39 @@
+// client_plugin.go
+package gravitee
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Get the subscription configuration schema of an entrypoint plugin.
+// Returns nil when the plugin does not expose one.
+func (c *Client) GetEntrypointSubscriptionSchema(entrypointID string) (map[string]interface{}, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/v2/plugins/entrypoints/%s/subscription-schema", c.ManagementURL, entrypointID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusNoContent {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var subscriptionSchema map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&subscriptionSchema); err != nil {
+		return nil, err
+	}
+
+	return subscriptionSchema, nil
+}
\ No newline at end of file
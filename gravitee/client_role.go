@@ -0,0 +1,157 @@
+// client_role.go
+package gravitee
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Role represents a Gravitee role. Permissions map a permission name to CRUD letters.
+type Role struct {
+	ID          string              `json:"id,omitempty"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Scope       string              `json:"scope"`
+	Default     bool                `json:"default"`
+	System      bool                `json:"system,omitempty"`
+	Permissions map[string][]string `json:"permissions"`
+}
+
+// Create a Role
+func (c *Client) CreateRole(role *Role) (*Role, error) {
+	body, err := json.Marshal(role)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/rolescopes/%s/roles", c.ManagementURL, role.Scope), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var createdRole Role
+	if err := json.NewDecoder(resp.Body).Decode(&createdRole); err != nil {
+		return nil, err
+	}
+
+	return &createdRole, nil
+}
+
+// Get a Role by scope and name
+func (c *Client) GetRole(scope string, name string) (*Role, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/rolescopes/%s/roles/%s", c.ManagementURL, scope, url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var role Role
+	if err := json.NewDecoder(resp.Body).Decode(&role); err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+// Update a Role
+func (c *Client) UpdateRole(role *Role) error {
+	body, err := json.Marshal(role)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/rolescopes/%s/roles/%s", c.ManagementURL, role.Scope, url.PathEscape(role.Name)), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Delete a Role
+func (c *Client) DeleteRole(scope string, name string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/rolescopes/%s/roles/%s", c.ManagementURL, scope, url.PathEscape(name)), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Get the permission catalog, mapping each role scope to its permission names
+func (c *Client) GetRoleScopes() (map[string][]string, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/rolescopes", c.ManagementURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var roleScopes map[string][]string
+	if err := json.NewDecoder(resp.Body).Decode(&roleScopes); err != nil {
+		return nil, err
+	}
+
+	return roleScopes, nil
+}
\ No newline at end of file
@@ -0,0 +1,74 @@
+// client_settings.go
+package gravitee
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// settingsURL returns the URL of the settings of the ORGANIZATION or of the ENVIRONMENT
+func (c *Client) settingsURL(referenceType string) string {
+	if referenceType == "ENVIRONMENT" {
+		return fmt.Sprintf("%s/management/organizations/DEFAULT/environments/DEFAULT/settings", c.ManagementURL)
+	}
+
+	return fmt.Sprintf("%s/management/organizations/DEFAULT/settings", c.ManagementURL)
+}
+
+// Get the settings of the ORGANIZATION or of the ENVIRONMENT as a JSON document
+func (c *Client) GetSettings(referenceType string) (map[string]interface{}, error) {
+	req, err := http.NewRequest("GET", c.settingsURL(referenceType), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var settings map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// Update the settings of the ORGANIZATION or of the ENVIRONMENT, the whole document is replaced
+func (c *Client) UpdateSettings(referenceType string, settings map[string]interface{}) error {
+	// Metadata such as read-only flags are computed by Gravitee
+	delete(settings, "metadata")
+
+	body, err := json.Marshal(settings)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", c.settingsURL(referenceType), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,271 @@
+// client_sharding_tag.go
+package gravitee
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ShardingTag represents a tag used to deploy APIs on a subset of gateways
+type ShardingTag struct {
+	ID               string   `json:"id,omitempty"`
+	Name             string   `json:"name"`
+	Description      string   `json:"description"`
+	RestrictedGroups []string `json:"restricted_groups"`
+}
+
+// EntrypointMapping tells the portal which URL to display for APIs deployed with some sharding tags
+type EntrypointMapping struct {
+	ID     string   `json:"id,omitempty"`
+	Value  string   `json:"value"`
+	Tags   []string `json:"tags"`
+	Target string   `json:"target,omitempty"`
+}
+
+// Create a Sharding Tag
+func (c *Client) CreateShardingTag(tag *ShardingTag) (*ShardingTag, error) {
+	body, err := json.Marshal(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/tags", c.ManagementURL), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var createdTag ShardingTag
+	if err := json.NewDecoder(resp.Body).Decode(&createdTag); err != nil {
+		return nil, err
+	}
+
+	return &createdTag, nil
+}
+
+// Get a Sharding Tag by ID
+func (c *Client) GetShardingTag(tagID string) (*ShardingTag, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/tags/%s", c.ManagementURL, tagID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var tag ShardingTag
+	if err := json.NewDecoder(resp.Body).Decode(&tag); err != nil {
+		return nil, err
+	}
+
+	return &tag, nil
+}
+
+// List all Sharding Tags
+func (c *Client) ListShardingTags() ([]ShardingTag, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/tags", c.ManagementURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var tags []ShardingTag
+	if err := json.NewDecoder(resp.Body).Decode(&tags); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// Update a Sharding Tag
+func (c *Client) UpdateShardingTag(tag *ShardingTag) error {
+	body, err := json.Marshal(tag)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/tags/%s", c.ManagementURL, tag.ID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Delete a Sharding Tag
+func (c *Client) DeleteShardingTag(tagID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/tags/%s", c.ManagementURL, tagID), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Create an Entrypoint Mapping
+func (c *Client) CreateEntrypointMapping(mapping *EntrypointMapping) (*EntrypointMapping, error) {
+	body, err := json.Marshal(mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/entrypoints", c.ManagementURL), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var createdMapping EntrypointMapping
+	if err := json.NewDecoder(resp.Body).Decode(&createdMapping); err != nil {
+		return nil, err
+	}
+
+	return &createdMapping, nil
+}
+
+// Get an Entrypoint Mapping by ID
+func (c *Client) GetEntrypointMapping(mappingID string) (*EntrypointMapping, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/entrypoints/%s", c.ManagementURL, mappingID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var mapping EntrypointMapping
+	if err := json.NewDecoder(resp.Body).Decode(&mapping); err != nil {
+		return nil, err
+	}
+
+	return &mapping, nil
+}
+
+// Update an Entrypoint Mapping
+func (c *Client) UpdateEntrypointMapping(mapping *EntrypointMapping) error {
+	body, err := json.Marshal(mapping)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/entrypoints/%s", c.ManagementURL, mapping.ID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Delete an Entrypoint Mapping
+func (c *Client) DeleteEntrypointMapping(mappingID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/entrypoints/%s", c.ManagementURL, mappingID), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
\ No newline at end of file
@@ -10,25 +10,152 @@ import (
 
 // Subscription represents a Gravitee API subscription
 type Subscription struct {
-	ID                    string                  `json:"id,omitempty"`
-	PlanID                string                  `json:"planId"`
-	ApplicationID         string                  `json:"applicationId"`
-	Status                string                  `json:"status,omitempty"`
-	ConsumerConfiguration *ConsumerConfiguration  `json:"consumerConfiguration,omitempty"`
-	Metadata              map[string]string       `json:"metadata,omitempty"`
+	ID                    string                 `json:"id,omitempty"`
+	PlanID                string                 `json:"planId"`
+	ApplicationID         string                 `json:"applicationId"`
+	Status                string                 `json:"status,omitempty"`
+	ConsumerConfiguration *ConsumerConfiguration `json:"consumerConfiguration,omitempty"`
+	Metadata              map[string]string      `json:"metadata,omitempty"`
+	StartingAt            string                 `json:"startingAt,omitempty"`
+	EndingAt              string                 `json:"endingAt,omitempty"`
+	PausedAt              string                 `json:"pausedAt,omitempty"`
+	PublisherMessage      string                 `json:"publisherMessage,omitempty"`
+	CustomAPIKey          string                 `json:"customApiKey,omitempty"`
 }
 
 // ConsumerConfiguration represents subscription consumer configuration
 type ConsumerConfiguration struct {
-	EntrypointID            string                   `json:"entrypointId"`
-	Channel                 string                   `json:"channel,omitempty"`
-	EntrypointConfiguration *EntrypointConfiguration `json:"entrypointConfiguration,omitempty"`
+	EntrypointID            string
+	Channel                 string
+	EntrypointConfiguration *EntrypointConfiguration
+	// RawEntrypointConfiguration holds the configuration of entrypoints that have no typed model.
+	// When set, it is sent instead of EntrypointConfiguration.
+	RawEntrypointConfiguration json.RawMessage
+}
+
+type consumerConfigurationJSON struct {
+	EntrypointID            string          `json:"entrypointId"`
+	Channel                 string          `json:"channel,omitempty"`
+	EntrypointConfiguration json.RawMessage `json:"entrypointConfiguration,omitempty"`
+}
+
+// MarshalJSON sends the raw entrypoint configuration when set, the typed one otherwise
+func (c ConsumerConfiguration) MarshalJSON() ([]byte, error) {
+	aux := consumerConfigurationJSON{
+		EntrypointID:            c.EntrypointID,
+		Channel:                 c.Channel,
+		EntrypointConfiguration: c.RawEntrypointConfiguration,
+	}
+
+	if len(aux.EntrypointConfiguration) == 0 && c.EntrypointConfiguration != nil {
+		entrypointConfig, err := json.Marshal(c.EntrypointConfiguration)
+		if err != nil {
+			return nil, err
+		}
+		aux.EntrypointConfiguration = entrypointConfig
+	}
+
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON keeps the raw entrypoint configuration alongside its typed form
+func (c *ConsumerConfiguration) UnmarshalJSON(data []byte) error {
+	var aux consumerConfigurationJSON
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	c.EntrypointID = aux.EntrypointID
+	c.Channel = aux.Channel
+	c.RawEntrypointConfiguration = aux.EntrypointConfiguration
+	c.EntrypointConfiguration = nil
+
+	if len(aux.EntrypointConfiguration) > 0 && string(aux.EntrypointConfiguration) != "null" {
+		var entrypointConfig EntrypointConfiguration
+		if err := json.Unmarshal(aux.EntrypointConfiguration, &entrypointConfig); err != nil {
+			return err
+		}
+		c.EntrypointConfiguration = &entrypointConfig
+	}
+
+	return nil
 }
 
 // EntrypointConfiguration represents entrypoint configuration
 type EntrypointConfiguration struct {
-	CallbackURL string   `json:"callbackUrl"`
-	Headers     []Header `json:"headers,omitempty"`
+	CallbackURL string        `json:"callbackUrl"`
+	Headers     []Header      `json:"headers,omitempty"`
+	Auth        *WebhookAuth  `json:"auth,omitempty"`
+	SSL         *WebhookSSL   `json:"ssl,omitempty"`
+	Retry       *WebhookRetry `json:"retry,omitempty"`
+	DLQ         *WebhookDLQ   `json:"dlq,omitempty"`
+}
+
+// WebhookAuth represents the authentication used to call a webhook
+type WebhookAuth struct {
+	Type   string             `json:"type"`
+	Basic  *WebhookBasicAuth  `json:"basic,omitempty"`
+	Token  *WebhookTokenAuth  `json:"token,omitempty"`
+	OAuth2 *WebhookOAuth2Auth `json:"oauth2,omitempty"`
+}
+
+// WebhookBasicAuth represents basic authentication credentials
+type WebhookBasicAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// WebhookTokenAuth represents a bearer token
+type WebhookTokenAuth struct {
+	Value string `json:"value"`
+}
+
+// WebhookOAuth2Auth represents OAuth2 client credentials
+type WebhookOAuth2Auth struct {
+	Endpoint     string   `json:"endpoint"`
+	ClientID     string   `json:"clientId"`
+	ClientSecret string   `json:"clientSecret"`
+	Scopes       []string `json:"scopes,omitempty"`
+}
+
+// WebhookSSL represents the SSL options used to call a webhook
+type WebhookSSL struct {
+	HostnameVerifier bool               `json:"hostnameVerifier"`
+	TrustAll         bool               `json:"trustAll"`
+	TrustStore       *WebhookTrustStore `json:"trustStore,omitempty"`
+	KeyStore         *WebhookKeyStore   `json:"keyStore,omitempty"`
+}
+
+// WebhookTrustStore represents a trust store
+type WebhookTrustStore struct {
+	Type     string `json:"type"`
+	Path     string `json:"path,omitempty"`
+	Content  string `json:"content,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// WebhookKeyStore represents a key store
+type WebhookKeyStore struct {
+	Type        string `json:"type"`
+	Path        string `json:"path,omitempty"`
+	Content     string `json:"content,omitempty"`
+	Password    string `json:"password,omitempty"`
+	CertContent string `json:"certContent,omitempty"`
+	KeyContent  string `json:"keyContent,omitempty"`
+}
+
+// WebhookRetry represents the retry policy applied when a webhook call fails
+type WebhookRetry struct {
+	RetryOption         string `json:"retryOption"`
+	RetryStrategy       string `json:"retryStrategy,omitempty"`
+	MaxAttempts         int    `json:"maxAttempts,omitempty"`
+	InitialDelaySeconds int    `json:"initialDelaySeconds,omitempty"`
+	MaxDelaySeconds     int    `json:"maxDelaySeconds,omitempty"`
+}
+
+// WebhookDLQ represents the dead letter queue receiving messages that could not be delivered
+type WebhookDLQ struct {
+	Endpoint string `json:"endpoint"`
 }
 
 // Header represents a HTTP header
@@ -100,11 +227,21 @@ func (c *Client) GetSubscription(apiID string, subscriptionID string) (*Subscrip
 }
 
 // Update a Subscription
-func (c *Client) UpdateSubscription(apiID string, subscription *Subscription) error {
-	body, err := json.Marshal(map[string]interface{}{
+func (c *Client) UpdateSubscription(apiID string, subscription *Subscription, updateEndingAt bool) error {
+	payload := map[string]interface{}{
 		"configuration": subscription.ConsumerConfiguration,
 		"metadata":      subscription.Metadata,
-	})
+	}
+
+	// The end date is only sent when it changes, so one set outside of Terraform is kept
+	if updateEndingAt {
+		payload["endingAt"] = nil
+		if subscription.EndingAt != "" {
+			payload["endingAt"] = subscription.EndingAt
+		}
+	}
+
+	body, err := json.Marshal(payload)
 	if err != nil {
 		return err
 	}
@@ -205,5 +342,78 @@ func (c *Client) AcceptSubscription(apiID string, subscriptionID string, reason
 		return fmt.Errorf("API returned status code %d", resp.StatusCode)
 	}
 
+	return nil
+}
+
+// Reject a pending Subscription
+func (c *Client) RejectSubscription(apiID string, subscriptionID string, reason string) error {
+	body, err := json.Marshal(map[string]string{
+		"reason": reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/v2/environments/DEFAULT/apis/%s/subscriptions/%s/_reject", c.ManagementURL, apiID, subscriptionID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Pause an accepted Subscription
+func (c *Client) PauseSubscription(apiID string, subscriptionID string) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/v2/environments/DEFAULT/apis/%s/subscriptions/%s/_pause", c.ManagementURL, apiID, subscriptionID), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Resume a paused Subscription
+func (c *Client) ResumeSubscription(apiID string, subscriptionID string) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/v2/environments/DEFAULT/apis/%s/subscriptions/%s/_resume", c.ManagementURL, apiID, subscriptionID), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
 	return nil
 }
\ No newline at end of file
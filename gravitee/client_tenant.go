@@ -0,0 +1,132 @@
+// client_tenant.go
+package gravitee
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Tenant represents a gateway tenant, used to route an API to different backends
+type Tenant struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Create a Tenant
+func (c *Client) CreateTenant(tenant *Tenant) (*Tenant, error) {
+	// The API creates tenants in bulk
+	body, err := json.Marshal([]*Tenant{tenant})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/tenants", c.ManagementURL), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var createdTenants []Tenant
+	if err := json.NewDecoder(resp.Body).Decode(&createdTenants); err != nil {
+		return nil, err
+	}
+
+	if len(createdTenants) != 1 {
+		return nil, fmt.Errorf("expected 1 created tenant, got %d", len(createdTenants))
+	}
+
+	return &createdTenants[0], nil
+}
+
+// Get a Tenant by ID
+func (c *Client) GetTenant(tenantID string) (*Tenant, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/tenants/%s", c.ManagementURL, tenantID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var tenant Tenant
+	if err := json.NewDecoder(resp.Body).Decode(&tenant); err != nil {
+		return nil, err
+	}
+
+	return &tenant, nil
+}
+
+// Update a Tenant
+func (c *Client) UpdateTenant(tenant *Tenant) error {
+	// The API updates tenants in bulk
+	body, err := json.Marshal([]*Tenant{tenant})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/tenants", c.ManagementURL), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Delete a Tenant
+func (c *Client) DeleteTenant(tenantID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/tenants/%s", c.ManagementURL, tenantID), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,116 @@
+// client_theme.go
+package gravitee
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Theme represents the branding of the classic portal (PORTAL) or of portal-next (PORTAL_NEXT)
+type Theme struct {
+	ID              string          `json:"id,omitempty"`
+	Name            string          `json:"name"`
+	Type            string          `json:"type"`
+	Enabled         bool            `json:"enabled"`
+	Logo            string          `json:"logo,omitempty"`
+	OptionalLogo    string          `json:"optionalLogo,omitempty"`
+	Favicon         string          `json:"favicon,omitempty"`
+	BackgroundImage string          `json:"backgroundImage,omitempty"`
+	Definition      json.RawMessage `json:"definition,omitempty"`
+}
+
+// PortalNextThemeDefinition is the definition of a PORTAL_NEXT theme
+type PortalNextThemeDefinition struct {
+	Color     *PortalNextThemeColor `json:"color,omitempty"`
+	Font      *PortalNextThemeFont  `json:"font,omitempty"`
+	CustomCSS string                `json:"customCss,omitempty"`
+}
+
+// PortalNextThemeColor holds the colors of a PORTAL_NEXT theme
+type PortalNextThemeColor struct {
+	Primary        string `json:"primary,omitempty"`
+	Secondary      string `json:"secondary,omitempty"`
+	Tertiary       string `json:"tertiary,omitempty"`
+	Error          string `json:"error,omitempty"`
+	PageBackground string `json:"pageBackground,omitempty"`
+	CardBackground string `json:"cardBackground,omitempty"`
+}
+
+// PortalNextThemeFont holds the font of a PORTAL_NEXT theme
+type PortalNextThemeFont struct {
+	FontFamily string `json:"fontFamily,omitempty"`
+}
+
+// Get a Theme by ID
+func (c *Client) GetTheme(themeID string) (*Theme, error) {
+	return c.getTheme(fmt.Sprintf("%s/management/v2/environments/DEFAULT/ui/themes/%s", c.ManagementURL, themeID))
+}
+
+// Get the Theme currently used by the portal of a type
+func (c *Client) GetCurrentTheme(themeType string) (*Theme, error) {
+	return c.getTheme(fmt.Sprintf("%s/management/v2/environments/DEFAULT/ui/themes/_current?type=%s", c.ManagementURL, url.QueryEscape(themeType)))
+}
+
+// Get the default Theme of a type, shipped with Gravitee
+func (c *Client) GetDefaultTheme(themeType string) (*Theme, error) {
+	return c.getTheme(fmt.Sprintf("%s/management/v2/environments/DEFAULT/ui/themes/_default?type=%s", c.ManagementURL, url.QueryEscape(themeType)))
+}
+
+func (c *Client) getTheme(themeURL string) (*Theme, error) {
+	req, err := http.NewRequest("GET", themeURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var theme Theme
+	if err := json.NewDecoder(resp.Body).Decode(&theme); err != nil {
+		return nil, err
+	}
+
+	return &theme, nil
+}
+
+// Update a Theme
+func (c *Client) UpdateTheme(theme *Theme) error {
+	body, err := json.Marshal(theme)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/management/v2/environments/DEFAULT/ui/themes/%s", c.ManagementURL, theme.ID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,316 @@
+// client_user.go
+package gravitee
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// User represents a Gravitee user
+type User struct {
+	ID        string                `json:"id,omitempty"`
+	FirstName string                `json:"firstname"`
+	LastName  string                `json:"lastname,omitempty"`
+	Email     string                `json:"email,omitempty"`
+	Source    string                `json:"source,omitempty"`
+	SourceID  string                `json:"sourceId,omitempty"`
+	Service   bool                  `json:"service,omitempty"`
+	Status    string                `json:"status,omitempty"`
+	Roles     []UserRole            `json:"roles,omitempty"`
+	EnvRoles  map[string][]UserRole `json:"envRoles,omitempty"`
+}
+
+// UserRole represents a role held by a user
+type UserRole struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Scope string `json:"scope,omitempty"`
+}
+
+// UserList represents a page of users
+type UserList struct {
+	Data []User `json:"data"`
+}
+
+// UserToken represents a personal access token of a user
+type UserToken struct {
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name"`
+	Token     string `json:"token,omitempty"`
+	CreatedAt int64  `json:"created_at,omitempty"`
+}
+
+// Create a User
+func (c *Client) CreateUser(user *User) (*User, error) {
+	body, err := json.Marshal(user)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/organizations/DEFAULT/users", c.ManagementURL), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var createdUser User
+	if err := json.NewDecoder(resp.Body).Decode(&createdUser); err != nil {
+		return nil, err
+	}
+
+	return &createdUser, nil
+}
+
+// Get a User by ID
+func (c *Client) GetUser(userID string) (*User, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/users/%s", c.ManagementURL, userID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var user User
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// Search Users matching a query, such as an email address
+func (c *Client) SearchUsers(query string) ([]User, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/users?q=%s&size=100", c.ManagementURL, url.QueryEscape(query)), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var users UserList
+	if err := json.NewDecoder(resp.Body).Decode(&users); err != nil {
+		return nil, err
+	}
+
+	return users.Data, nil
+}
+
+// Update a User
+func (c *Client) UpdateUser(user *User) error {
+	body, err := json.Marshal(user)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/management/organizations/DEFAULT/users/%s", c.ManagementURL, user.ID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Delete a User
+func (c *Client) DeleteUser(userID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/management/organizations/DEFAULT/users/%s", c.ManagementURL, userID), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Replace the roles of a User on a reference, referenceType being ORGANIZATION or ENVIRONMENT
+func (c *Client) UpdateUserRoles(userID string, referenceType string, referenceID string, roleIDs []string) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"user":          userID,
+		"referenceType": referenceType,
+		"referenceId":   referenceID,
+		"roles":         roleIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/management/organizations/DEFAULT/users/%s/roles", c.ManagementURL, userID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Remove a role from a User, scope being ORGANIZATION or ENVIRONMENT
+func (c *Client) DeleteUserRole(scope string, roleName string, userID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/management/organizations/DEFAULT/configuration/rolescopes/%s/roles/%s/users/%s", c.ManagementURL, scope, url.PathEscape(roleName), userID), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Create a personal access Token for a User. The token value is only returned on creation.
+func (c *Client) CreateUserToken(userID string, token *UserToken) (*UserToken, error) {
+	body, err := json.Marshal(token)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/management/organizations/DEFAULT/users/%s/tokens", c.ManagementURL, userID), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var createdToken UserToken
+	if err := json.NewDecoder(resp.Body).Decode(&createdToken); err != nil {
+		return nil, err
+	}
+
+	return &createdToken, nil
+}
+
+// List the personal access Tokens of a User, without their values
+func (c *Client) ListUserTokens(userID string) ([]UserToken, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/management/organizations/DEFAULT/users/%s/tokens", c.ManagementURL, userID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	var tokens []UserToken
+	if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// Revoke a personal access Token of a User
+func (c *Client) DeleteUserToken(userID string, tokenID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/management/organizations/DEFAULT/users/%s/tokens/%s", c.ManagementURL, userID, tokenID), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Username, c.Password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
\ No newline at end of file
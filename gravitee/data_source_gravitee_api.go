@@ -48,6 +48,42 @@ func dataSourceGraviteeAPI() *schema.Resource {
 				Computed:    true,
 				Description: "State of the API (STARTED, STOPPED)",
 			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Portal lifecycle state of the API (CREATED, PUBLISHED, UNPUBLISHED, DEPRECATED, ARCHIVED)",
+			},
+			"visibility": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Visibility of the API in the portal (PUBLIC, PRIVATE)",
+			},
+			"out_of_sync": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the definition deployed on the gateways differs from the current one",
+			},
+			"workflow_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Review workflow state of the API (DRAFT, IN_REVIEW, REQUEST_FOR_CHANGES, REVIEW_OK). Empty when API review is not enabled",
+			},
+			"categories": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Portal categories of the API",
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Sharding tags of the API",
+			},
 			"created_at": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -78,4 +114,34 @@ func dataSourceGraviteeAPIRead(ctx context.Context, d *schema.ResourceData, m in
 	api, err := client.GetAPI(apiID)
 	if err != nil {
 		return diag.FromErr(err)
-	}
\ No newline at end of file
+	}
+
+	if api == nil {
+		return diag.Errorf("API %q not found", apiID)
+	}
+
+	d.SetId(api.ID)
+	d.Set("name", api.Name)
+	d.Set("description", api.Description)
+	d.Set("api_version", api.APIVersion)
+	d.Set("definition_version", api.DefinitionVersion)
+	d.Set("type", api.Type)
+	d.Set("state", api.State)
+	d.Set("out_of_sync", apiOutOfSync(api))
+	d.Set("workflow_state", api.WorkflowState)
+	d.Set("lifecycle_state", api.LifecycleState)
+	d.Set("visibility", api.Visibility)
+	d.Set("created_at", apiTimestamp(api.CreatedAt))
+	d.Set("updated_at", apiTimestamp(api.UpdatedAt))
+	d.Set("deployed_at", apiTimestamp(api.DeployedAt))
+
+	if err := d.Set("categories", api.Categories); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("tags", api.Tags); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
\ No newline at end of file
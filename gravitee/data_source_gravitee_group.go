@@ -0,0 +1,109 @@
+// data_source_gravitee_group.go
+package gravitee
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGraviteeGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGraviteeGroupRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the group",
+			},
+			"event_rules": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Events adding the group to new APIs or applications automatically",
+			},
+			"default_api_role": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Role given on APIs to new members of the group",
+			},
+			"default_application_role": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Role given on applications to new members of the group",
+			},
+			"max_invitation": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Maximum number of members that can be invited in the group",
+			},
+			"lock_api_role": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the API role of members can only be changed by administrators",
+			},
+			"lock_application_role": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the application role of members can only be changed by administrators",
+			},
+			"system_invitation": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether group administrators can invite existing users",
+			},
+			"email_invitation": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether group administrators can invite users by email",
+			},
+			"disable_membership_notifications": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether membership notifications are disabled",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func dataSourceGraviteeGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	name := d.Get("name").(string)
+
+	groups, err := client.ListGroups()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var group *gravitee.Group
+	for i := range groups {
+		if groups[i].Name != name {
+			continue
+		}
+
+		if group != nil {
+			return diag.Errorf("more than one group is named %q", name)
+		}
+		group = &groups[i]
+	}
+
+	if group == nil {
+		return diag.Errorf("no group named %q", name)
+	}
+
+	d.SetId(group.ID)
+
+	if err := flattenGroup(d, group); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
\ No newline at end of file
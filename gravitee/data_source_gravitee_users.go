@@ -0,0 +1,106 @@
+// data_source_gravitee_users.go
+package gravitee
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGraviteeUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGraviteeUsersRead,
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"email", "query"},
+				Description:  "Email of the users to look up, matched exactly",
+			},
+			"query": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"email", "query"},
+				Description:  "Free text search on the users",
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Users matching the lookup",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"first_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func dataSourceGraviteeUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	email := d.Get("email").(string)
+	query := d.Get("query").(string)
+	if email != "" {
+		query = email
+	}
+
+	users, err := client.SearchUsers(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := make([]interface{}, 0, len(users))
+	for _, user := range users {
+		if email != "" && !strings.EqualFold(user.Email, email) {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":         user.ID,
+			"email":      user.Email,
+			"first_name": user.FirstName,
+			"last_name":  user.LastName,
+			"source":     user.Source,
+			"status":     user.Status,
+		})
+	}
+
+	d.SetId(query)
+
+	if err := d.Set("users", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
\ No newline at end of file
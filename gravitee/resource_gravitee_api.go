@@ -0,0 +1,1275 @@
+// resource_gravitee_api.go
+package gravitee
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGraviteeAPI() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeAPICreate,
+		ReadContext:   resourceGraviteeAPIRead,
+		UpdateContext: resourceGraviteeAPIUpdate,
+		DeleteContext: resourceGraviteeAPIDelete,
+		CustomizeDiff: resourceGraviteeAPICustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the API",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the API",
+			},
+			"api_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Version of the API",
+			},
+			"definition_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "V4",
+				ValidateFunc: validation.StringInSlice([]string{"V4"}, false),
+				Description:  "Definition version of the API. Only V4 is supported",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PROXY", "MESSAGE"}, false),
+				Description:  "Type of the API (PROXY, MESSAGE)",
+			},
+			"listeners": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Listeners exposing the API on the gateway",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"HTTP", "SUBSCRIPTION", "TCP"}, false),
+							Description:  "Type of the listener (HTTP, SUBSCRIPTION, TCP)",
+						},
+						"paths": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Context paths of an HTTP listener",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"entrypoints": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Entrypoints of the listener",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Type of the entrypoint plugin (e.g., http-proxy, webhook)",
+									},
+									"configuration": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+										Description: "Configuration of the entrypoint. Dotted keys denote nested properties, and values that are valid JSON, such as 3000, true or [\"a\"], are sent decoded",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"endpoint_groups": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Groups of backend endpoints of the API",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Type of the endpoint plugin (e.g., http-proxy, kafka)",
+						},
+						"shared_configuration": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: "Configuration shared by the endpoints of the group. Dotted keys denote nested properties, and values that are valid JSON, such as 3000, true or [\"a\"], are sent decoded",
+						},
+						"endpoints": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"weight": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"inherit_configuration": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     false,
+										Description: "Whether the endpoint uses the shared configuration of its group",
+									},
+									"tenants": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+										Description: "IDs of the tenants the endpoint is used for. Gateways of other tenants skip it",
+									},
+									"configuration": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+										Description: "Configuration of the endpoint. Dotted keys denote nested properties, and values that are valid JSON, such as 3000, true or [\"a\"], are sent decoded",
+									},
+									"shared_configuration_override": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+										Description: "Overrides of the shared configuration of the group. Dotted keys denote nested properties, and values that are valid JSON, such as 3000, true or [\"a\"], are sent decoded",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"analytics": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Analytics and logging settings of the API",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"logging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"mode":    loggingFlagsSchema("entrypoint", "endpoint"),
+									"phase":   loggingFlagsSchema("request", "response"),
+									"content": loggingFlagsSchema("headers", "payload"),
+								},
+							},
+						},
+					},
+				},
+			},
+			"flows": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Flows of the API",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"selectors": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Selectors restricting the calls the flow applies to",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"HTTP", "CHANNEL", "CONDITION"}, false),
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"path_operator": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"EQUALS", "STARTS_WITH"}, false),
+									},
+									"methods": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"channel": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"channel_operator": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"EQUALS", "STARTS_WITH"}, false),
+									},
+									"operations": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"condition": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"request":   flowStepsSchema("Policies executed on the request"),
+						"response":  flowStepsSchema("Policies executed on the response"),
+						"subscribe": flowStepsSchema("Policies executed on the messages sent to subscribers"),
+						"publish":   flowStepsSchema("Policies executed on the messages published by clients"),
+					},
+				},
+			},
+			"categories": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "IDs or keys of the portal categories of the API",
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "IDs of the sharding tags restricting the gateways the API is deployed on",
+			},
+			"lifecycle_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"CREATED", "PUBLISHED", "UNPUBLISHED", "DEPRECATED", "ARCHIVED"}, false),
+				Description:  "Portal lifecycle state of the API (CREATED, PUBLISHED, UNPUBLISHED, DEPRECATED, ARCHIVED). Archiving is final",
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PUBLIC", "PRIVATE"}, false),
+				Description:  "Visibility of the API in the portal (PUBLIC, PRIVATE)",
+			},
+			"auto_start": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to start the API once it is created",
+			},
+			"deployment_label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Label of the deployments triggered by updates, shown in the history of the API",
+			},
+			"batch_deployments": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to leave deployments to a gravitee_api_deployment resource, deploying all the changes of an apply at once, instead of deploying after each update",
+			},
+			"request_review": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to ask for a review of the API when it is created or updated in DRAFT or REQUEST_FOR_CHANGES. Requires API review to be enabled",
+			},
+			"review_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Message sent with the review request",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the API (STARTED, STOPPED)",
+			},
+			"out_of_sync": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the definition deployed on the gateways differs from the current one",
+			},
+			"workflow_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Review workflow state of the API (DRAFT, IN_REVIEW, REQUEST_FOR_CHANGES, REVIEW_OK). Empty when API review is not enabled",
+			},
+			"created_at": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Creation timestamp of the API",
+			},
+			"updated_at": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Last update timestamp of the API",
+			},
+			"deployed_at": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Last deployment timestamp of the API",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func loggingFlagsSchema(flags ...string) *schema.Schema {
+	flagsSchema := make(map[string]*schema.Schema, len(flags))
+	for _, flag := range flags {
+		flagsSchema[flag] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: flagsSchema,
+		},
+	}
+}
+
+func flowStepsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+				"policy": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "ID of the policy plugin",
+				},
+				"configuration": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Configuration of the policy. Dotted keys denote nested properties, and values that are valid JSON, such as 3000, true or [\"a\"], are sent decoded",
+				},
+			},
+		},
+	}
+}
+
+// apiDeploymentAttributes only change how the API is deployed or reviewed, or are read from
+// Gravitee, so changing them neither updates nor deploys the API
+var apiDeploymentAttributes = []string{
+	"deployment_label", "batch_deployments", "request_review", "review_message", "auto_start",
+	"state", "out_of_sync", "workflow_state", "created_at", "updated_at", "deployed_at",
+}
+
+// resourceGraviteeAPICustomizeDiff checks the lifecycle transition and that the categories, endpoint
+// tenants and sharding tags exist. It plans a deployment when the API is out of sync, and new
+// timestamps and deployment state when the definition changes, so gravitee_api_deployment triggers
+// referencing them change in the same plan.
+func resourceGraviteeAPICustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	client := m.(*gravitee.Client)
+
+	if d.Id() != "" && apiDefinitionChanged(d.GetChangedKeysPrefix("")) {
+		computed := []string{"updated_at", "out_of_sync"}
+		if !d.Get("batch_deployments").(bool) {
+			computed = append(computed, "deployed_at")
+		}
+
+		for _, key := range computed {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	} else if d.Id() != "" && d.Get("out_of_sync").(bool) && !d.Get("batch_deployments").(bool) {
+		if err := d.SetNewComputed("out_of_sync"); err != nil {
+			return err
+		}
+	}
+
+	if d.Id() != "" && d.HasChange("lifecycle_state") {
+		from, to := d.GetChange("lifecycle_state")
+		if err := validateAPILifecycleTransition(from.(string), to.(string)); err != nil {
+			return err
+		}
+	}
+
+	if err := validateAPICategories(d, client); err != nil {
+		return err
+	}
+
+	if err := validateEndpointTenants(d, client); err != nil {
+		return err
+	}
+
+	return validateShardingTags(d, client, "tags")
+}
+
+// apiLifecycleTransitions lists the lifecycle states an API can move to from each state.
+// Archiving is final and deprecated APIs cannot be published again.
+var apiLifecycleTransitions = map[string][]string{
+	"CREATED":     {"PUBLISHED", "UNPUBLISHED", "DEPRECATED", "ARCHIVED"},
+	"PUBLISHED":   {"UNPUBLISHED", "DEPRECATED", "ARCHIVED"},
+	"UNPUBLISHED": {"PUBLISHED", "DEPRECATED", "ARCHIVED"},
+	"DEPRECATED":  {"ARCHIVED"},
+	"ARCHIVED":    {},
+}
+
+// validateAPILifecycleTransition rejects the lifecycle changes Gravitee does not allow, such as un-archiving
+func validateAPILifecycleTransition(from string, to string) error {
+	if from == "" || to == "" || from == to {
+		return nil
+	}
+
+	allowed, ok := apiLifecycleTransitions[from]
+	if !ok {
+		return fmt.Errorf("unknown lifecycle state %q", from)
+	}
+
+	for _, state := range allowed {
+		if state == to {
+			return nil
+		}
+	}
+
+	if len(allowed) == 0 {
+		return fmt.Errorf("the API is %s, its lifecycle state cannot be changed to %s", from, to)
+	}
+
+	allowed = append([]string(nil), allowed...)
+	sort.Strings(allowed)
+	return fmt.Errorf("the API cannot move from %s to %s, expected one of: %s", from, to, strings.Join(allowed, ", "))
+}
+
+func validateAPICategories(d *schema.ResourceDiff, client *gravitee.Client) error {
+	if !d.HasChange("categories") || !d.NewValueKnown("categories") {
+		return nil
+	}
+
+	for _, value := range d.Get("categories").(*schema.Set).List() {
+		category, err := client.GetCategory(value.(string))
+		if err != nil {
+			return err
+		}
+
+		if category == nil {
+			return fmt.Errorf("category %q not found", value)
+		}
+	}
+
+	return nil
+}
+
+func validateEndpointTenants(d *schema.ResourceDiff, client *gravitee.Client) error {
+	if !d.HasChange("endpoint_groups") || !d.NewValueKnown("endpoint_groups") {
+		return nil
+	}
+
+	for _, tenantID := range endpointTenants(d.Get("endpoint_groups").([]interface{}), d.NewValueKnown) {
+		tenant, err := client.GetTenant(tenantID)
+		if err != nil {
+			return err
+		}
+
+		if tenant == nil {
+			return fmt.Errorf("tenant %q not found", tenantID)
+		}
+	}
+
+	return nil
+}
+
+// endpointTenants returns the distinct tenants of the endpoints, sorted. The tenants of an endpoint
+// are skipped when known reports them as not known yet, such as tenants created in the same run.
+func endpointTenants(endpointGroups []interface{}, known func(key string) bool) []string {
+	seen := make(map[string]bool)
+	tenants := make([]string, 0)
+	for i, g := range endpointGroups {
+		groupMap, ok := g.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		endpoints, _ := groupMap["endpoints"].([]interface{})
+		for j, e := range endpoints {
+			endpointMap, ok := e.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			set, ok := endpointMap["tenants"].(*schema.Set)
+			if !ok || !known(fmt.Sprintf("endpoint_groups.%d.endpoints.%d.tenants", i, j)) {
+				continue
+			}
+
+			for _, tenant := range set.List() {
+				tenantID, _ := tenant.(string)
+				if tenantID == "" || seen[tenantID] {
+					continue
+				}
+				seen[tenantID] = true
+				tenants = append(tenants, tenantID)
+			}
+		}
+	}
+	sort.Strings(tenants)
+
+	return tenants
+}
+
+func resourceGraviteeAPICreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	api := expandAPI(d)
+
+	createdAPI, err := client.CreateAPI(api)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdAPI.ID)
+
+	// The lifecycle state and the visibility can only be set once the API exists
+	if (api.LifecycleState != "" && api.LifecycleState != createdAPI.LifecycleState) || (api.Visibility != "" && api.Visibility != createdAPI.Visibility) {
+		api.ID = createdAPI.ID
+		err = client.UpdateAPI(api)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Batched deployments are made once by gravitee_api_deployment after all the changes
+	if !d.Get("batch_deployments").(bool) {
+		err = client.DeployAPI(createdAPI.ID, d.Get("deployment_label").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("auto_start").(bool) {
+		err = client.StartAPI(createdAPI.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = requestAPIReview(d, client, createdAPI)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGraviteeAPIRead(ctx, d, m)
+}
+
+func resourceGraviteeAPIRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	api, err := client.GetAPI(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if api == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Flatten the API object and set to ResourceData
+	if err := flattenAPI(d, api); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Categories are returned in a single form, keep the IDs or keys used in the configuration. The
+	// categories are only listed when a configured value is not returned as is
+	configuredValues := expandStringList(d.Get("categories").(*schema.Set).List())
+	var categories []gravitee.Category
+	if !containsAll(api.Categories, configuredValues) {
+		categories, err = client.ListCategories()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set("categories", apiCategoriesInConfiguredForm(configuredCategories(configuredValues, categories), api.Categories)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGraviteeAPIUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	definitionChanged := d.HasChangesExcept(apiDeploymentAttributes...)
+	if definitionChanged {
+		api := expandAPI(d)
+		api.ID = d.Id()
+
+		err := client.UpdateAPI(api)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Batched deployments are made once by gravitee_api_deployment after all the changes
+	wasOutOfSync, _ := d.GetChange("out_of_sync")
+	if !d.Get("batch_deployments").(bool) && (definitionChanged || wasOutOfSync.(bool)) {
+		err := client.DeployAPI(d.Id(), d.Get("deployment_label").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("request_review").(bool) {
+		updatedAPI, err := client.GetAPI(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if updatedAPI != nil {
+			err = requestAPIReview(d, client, updatedAPI)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceGraviteeAPIRead(ctx, d, m)
+}
+
+// requestAPIReview asks for a review of the API when request_review is set and the API is not under review
+func requestAPIReview(d *schema.ResourceData, client *gravitee.Client, api *gravitee.API) error {
+	if !d.Get("request_review").(bool) {
+		return nil
+	}
+
+	workflowState, err := apiWorkflowState(api)
+	if err != nil {
+		return err
+	}
+
+	if !apiReviewRequestable(workflowState) {
+		return nil
+	}
+
+	return client.AskForAPIReview(api.ID, d.Get("review_message").(string))
+}
+
+func resourceGraviteeAPIDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	api, err := client.GetAPI(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if api == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Gravitee refuses to delete a started API
+	if api.State == "STARTED" {
+		err = client.StopAPI(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = client.DeleteAPI(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper functions for expanding and flattening API objects
+func expandAPI(d *schema.ResourceData) *gravitee.API {
+	api := &gravitee.API{
+		Name:              d.Get("name").(string),
+		Description:       d.Get("description").(string),
+		APIVersion:        d.Get("api_version").(string),
+		DefinitionVersion: d.Get("definition_version").(string),
+		Type:              d.Get("type").(string),
+		Listeners:         make([]gravitee.Listener, 0),
+		EndpointGroups:    make([]gravitee.EndpointGroup, 0),
+		Flows:             make([]gravitee.Flow, 0),
+		Categories:        expandStringList(d.Get("categories").(*schema.Set).List()),
+		Tags:              expandStringList(d.Get("tags").(*schema.Set).List()),
+		LifecycleState:    d.Get("lifecycle_state").(string),
+		Visibility:        d.Get("visibility").(string),
+	}
+
+	for _, v := range d.Get("listeners").([]interface{}) {
+		listenerMap := v.(map[string]interface{})
+		listener := gravitee.Listener{
+			Type: listenerMap["type"].(string),
+		}
+
+		for _, p := range listenerMap["paths"].([]interface{}) {
+			listener.Paths = append(listener.Paths, gravitee.ListenerPath{
+				Path: p.(map[string]interface{})["path"].(string),
+			})
+		}
+
+		for _, e := range listenerMap["entrypoints"].([]interface{}) {
+			entrypointMap := e.(map[string]interface{})
+			listener.Entrypoints = append(listener.Entrypoints, gravitee.Entrypoint{
+				Type:          entrypointMap["type"].(string),
+				Configuration: expandConfigurationMap(entrypointMap["configuration"].(map[string]interface{})),
+			})
+		}
+
+		api.Listeners = append(api.Listeners, listener)
+	}
+
+	for _, v := range d.Get("endpoint_groups").([]interface{}) {
+		groupMap := v.(map[string]interface{})
+		group := gravitee.EndpointGroup{
+			Name:                groupMap["name"].(string),
+			Type:                groupMap["type"].(string),
+			SharedConfiguration: expandConfigurationMap(groupMap["shared_configuration"].(map[string]interface{})),
+		}
+
+		for _, e := range groupMap["endpoints"].([]interface{}) {
+			group.Endpoints = append(group.Endpoints, expandEndpoint(e.(map[string]interface{})))
+		}
+
+		api.EndpointGroups = append(api.EndpointGroups, group)
+	}
+
+	if v, ok := d.GetOk("analytics"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		api.Analytics = expandAnalytics(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	for _, v := range d.Get("flows").([]interface{}) {
+		api.Flows = append(api.Flows, expandFlow(v.(map[string]interface{})))
+	}
+
+	return api
+}
+
+func expandEndpoint(endpointMap map[string]interface{}) gravitee.Endpoint {
+	return gravitee.Endpoint{
+		Name:                        endpointMap["name"].(string),
+		Type:                        endpointMap["type"].(string),
+		Weight:                      endpointMap["weight"].(int),
+		InheritConfiguration:        endpointMap["inherit_configuration"].(bool),
+		Tenants:                     expandStringList(endpointMap["tenants"].(*schema.Set).List()),
+		Configuration:               expandConfigurationMap(endpointMap["configuration"].(map[string]interface{})),
+		SharedConfigurationOverride: expandConfigurationMap(endpointMap["shared_configuration_override"].(map[string]interface{})),
+	}
+}
+
+func expandAnalytics(analyticsMap map[string]interface{}) *gravitee.Analytics {
+	analytics := &gravitee.Analytics{
+		Enabled: analyticsMap["enabled"].(bool),
+	}
+
+	logging, ok := analyticsMap["logging"].([]interface{})
+	if !ok || len(logging) == 0 || logging[0] == nil {
+		return analytics
+	}
+
+	loggingMap := logging[0].(map[string]interface{})
+	analytics.Logging = &gravitee.AnalyticsLogging{}
+
+	if mode := loggingFlags(loggingMap["mode"]); mode != nil {
+		analytics.Logging.Mode = &gravitee.LoggingMode{
+			Entrypoint: mode["entrypoint"].(bool),
+			Endpoint:   mode["endpoint"].(bool),
+		}
+	}
+
+	if phase := loggingFlags(loggingMap["phase"]); phase != nil {
+		analytics.Logging.Phase = &gravitee.LoggingPhase{
+			Request:  phase["request"].(bool),
+			Response: phase["response"].(bool),
+		}
+	}
+
+	if content := loggingFlags(loggingMap["content"]); content != nil {
+		analytics.Logging.Content = &gravitee.LoggingContent{
+			Headers: content["headers"].(bool),
+			Payload: content["payload"].(bool),
+		}
+	}
+
+	return analytics
+}
+
+// loggingFlags returns the flags of a logging block, or nil when the block is not declared
+func loggingFlags(raw interface{}) map[string]interface{} {
+	blocks, ok := raw.([]interface{})
+	if !ok || len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+
+	return blocks[0].(map[string]interface{})
+}
+
+func expandFlow(flowMap map[string]interface{}) gravitee.Flow {
+	flow := gravitee.Flow{
+		Name:      flowMap["name"].(string),
+		Enabled:   flowMap["enabled"].(bool),
+		Selectors: make([]gravitee.FlowSelector, 0),
+		Request:   expandFlowSteps(flowMap["request"].([]interface{})),
+		Response:  expandFlowSteps(flowMap["response"].([]interface{})),
+		Subscribe: expandFlowSteps(flowMap["subscribe"].([]interface{})),
+		Publish:   expandFlowSteps(flowMap["publish"].([]interface{})),
+	}
+
+	for _, s := range flowMap["selectors"].([]interface{}) {
+		selectorMap := s.(map[string]interface{})
+		flow.Selectors = append(flow.Selectors, gravitee.FlowSelector{
+			Type:            selectorMap["type"].(string),
+			Path:            selectorMap["path"].(string),
+			PathOperator:    selectorMap["path_operator"].(string),
+			Methods:         expandStringList(selectorMap["methods"].(*schema.Set).List()),
+			Channel:         selectorMap["channel"].(string),
+			ChannelOperator: selectorMap["channel_operator"].(string),
+			Operations:      expandStringList(selectorMap["operations"].(*schema.Set).List()),
+			Condition:       selectorMap["condition"].(string),
+		})
+	}
+
+	return flow
+}
+
+func expandFlowSteps(raw []interface{}) []gravitee.FlowStep {
+	steps := make([]gravitee.FlowStep, 0, len(raw))
+	for _, s := range raw {
+		stepMap := s.(map[string]interface{})
+		steps = append(steps, gravitee.FlowStep{
+			Name:          stepMap["name"].(string),
+			Description:   stepMap["description"].(string),
+			Enabled:       stepMap["enabled"].(bool),
+			Policy:        stepMap["policy"].(string),
+			Configuration: expandConfigurationMap(stepMap["configuration"].(map[string]interface{})),
+		})
+	}
+
+	return steps
+}
+
+func flattenAPI(d *schema.ResourceData, api *gravitee.API) error {
+	d.Set("name", api.Name)
+	d.Set("description", api.Description)
+	d.Set("api_version", api.APIVersion)
+	d.Set("definition_version", api.DefinitionVersion)
+	d.Set("type", api.Type)
+	d.Set("state", api.State)
+	d.Set("out_of_sync", apiOutOfSync(api))
+	d.Set("workflow_state", api.WorkflowState)
+	d.Set("lifecycle_state", api.LifecycleState)
+	d.Set("visibility", api.Visibility)
+	d.Set("created_at", apiTimestamp(api.CreatedAt))
+	d.Set("updated_at", apiTimestamp(api.UpdatedAt))
+	d.Set("deployed_at", apiTimestamp(api.DeployedAt))
+
+	if err := d.Set("tags", api.Tags); err != nil {
+		return err
+	}
+
+	listeners := make([]interface{}, len(api.Listeners))
+	for i, listener := range api.Listeners {
+		paths := make([]interface{}, len(listener.Paths))
+		for j, path := range listener.Paths {
+			paths[j] = map[string]interface{}{
+				"path": path.Path,
+			}
+		}
+
+		entrypoints := make([]interface{}, len(listener.Entrypoints))
+		for j, entrypoint := range listener.Entrypoints {
+			entrypoints[j] = map[string]interface{}{
+				"type":          entrypoint.Type,
+				"configuration": flattenConfigurationMap(entrypoint.Configuration),
+			}
+		}
+
+		listeners[i] = map[string]interface{}{
+			"type":        listener.Type,
+			"paths":       paths,
+			"entrypoints": entrypoints,
+		}
+	}
+
+	if err := d.Set("listeners", listeners); err != nil {
+		return err
+	}
+
+	endpointGroups := make([]interface{}, len(api.EndpointGroups))
+	for i, group := range api.EndpointGroups {
+		endpoints := make([]interface{}, len(group.Endpoints))
+		for j, endpoint := range group.Endpoints {
+			endpoints[j] = flattenEndpoint(endpoint)
+		}
+
+		endpointGroups[i] = map[string]interface{}{
+			"name":                 group.Name,
+			"type":                 group.Type,
+			"shared_configuration": flattenConfigurationMap(group.SharedConfiguration),
+			"endpoints":            endpoints,
+		}
+	}
+
+	if err := d.Set("endpoint_groups", endpointGroups); err != nil {
+		return err
+	}
+
+	if api.Analytics != nil {
+		if err := d.Set("analytics", []interface{}{flattenAnalytics(api.Analytics)}); err != nil {
+			return err
+		}
+	}
+
+	flows := make([]interface{}, len(api.Flows))
+	for i, flow := range api.Flows {
+		flows[i] = flattenFlow(flow)
+	}
+
+	if err := d.Set("flows", flows); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func flattenEndpoint(endpoint gravitee.Endpoint) map[string]interface{} {
+	return map[string]interface{}{
+		"name":                          endpoint.Name,
+		"type":                          endpoint.Type,
+		"weight":                        endpoint.Weight,
+		"inherit_configuration":         endpoint.InheritConfiguration,
+		"tenants":                       flattenStringList(endpoint.Tenants),
+		"configuration":                 flattenConfigurationMap(endpoint.Configuration),
+		"shared_configuration_override": flattenConfigurationMap(endpoint.SharedConfigurationOverride),
+	}
+}
+
+func flattenAnalytics(analytics *gravitee.Analytics) map[string]interface{} {
+	result := map[string]interface{}{
+		"enabled": analytics.Enabled,
+	}
+
+	if analytics.Logging == nil {
+		return result
+	}
+
+	logging := map[string]interface{}{}
+
+	if mode := analytics.Logging.Mode; mode != nil {
+		logging["mode"] = []interface{}{map[string]interface{}{
+			"entrypoint": mode.Entrypoint,
+			"endpoint":   mode.Endpoint,
+		}}
+	}
+
+	if phase := analytics.Logging.Phase; phase != nil {
+		logging["phase"] = []interface{}{map[string]interface{}{
+			"request":  phase.Request,
+			"response": phase.Response,
+		}}
+	}
+
+	if content := analytics.Logging.Content; content != nil {
+		logging["content"] = []interface{}{map[string]interface{}{
+			"headers": content.Headers,
+			"payload": content.Payload,
+		}}
+	}
+
+	result["logging"] = []interface{}{logging}
+
+	return result
+}
+
+func flattenFlow(flow gravitee.Flow) map[string]interface{} {
+	selectors := make([]interface{}, len(flow.Selectors))
+	for i, selector := range flow.Selectors {
+		selectors[i] = map[string]interface{}{
+			"type":             selector.Type,
+			"path":             selector.Path,
+			"path_operator":    selector.PathOperator,
+			"methods":          flattenStringList(selector.Methods),
+			"channel":          selector.Channel,
+			"channel_operator": selector.ChannelOperator,
+			"operations":       flattenStringList(selector.Operations),
+			"condition":        selector.Condition,
+		}
+	}
+
+	return map[string]interface{}{
+		"name":      flow.Name,
+		"enabled":   flow.Enabled,
+		"selectors": selectors,
+		"request":   flattenFlowSteps(flow.Request),
+		"response":  flattenFlowSteps(flow.Response),
+		"subscribe": flattenFlowSteps(flow.Subscribe),
+		"publish":   flattenFlowSteps(flow.Publish),
+	}
+}
+
+func flattenFlowSteps(steps []gravitee.FlowStep) []interface{} {
+	result := make([]interface{}, len(steps))
+	for i, step := range steps {
+		result[i] = map[string]interface{}{
+			"name":          step.Name,
+			"description":   step.Description,
+			"enabled":       step.Enabled,
+			"policy":        step.Policy,
+			"configuration": flattenConfigurationMap(step.Configuration),
+		}
+	}
+
+	return result
+}
+
+// configuredCategories maps each configured category value to the category it is the ID or the
+// key of, or to nil when it matches none
+func configuredCategories(values []string, categories []gravitee.Category) map[string]*gravitee.Category {
+	configured := make(map[string]*gravitee.Category, len(values))
+	for _, value := range values {
+		configured[value] = nil
+		for i := range categories {
+			if categories[i].ID == value || categories[i].Key == value {
+				configured[value] = &categories[i]
+				break
+			}
+		}
+	}
+
+	return configured
+}
+
+// containsAll tells whether every value is in the list
+func containsAll(list []string, values []string) bool {
+	present := make(map[string]bool, len(list))
+	for _, item := range list {
+		present[item] = true
+	}
+
+	for _, value := range values {
+		if !present[value] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// apiDefinitionChanged tells whether the changed keys of a diff update the definition of the API
+func apiDefinitionChanged(changedKeys []string) bool {
+	for _, key := range changedKeys {
+		attribute := strings.Split(key, ".")[0]
+
+		deploymentAttribute := false
+		for _, excluded := range apiDeploymentAttributes {
+			if attribute == excluded {
+				deploymentAttribute = true
+				break
+			}
+		}
+
+		if !deploymentAttribute {
+			return true
+		}
+	}
+
+	return false
+}
+
+// apiCategoriesInConfiguredForm returns the categories of an API as they are written in the
+// configuration. Gravitee accepts category IDs and keys but only returns one form, so a category
+// matching a configured value by ID or key is reported with that value and any other category
+// shows up as drift.
+func apiCategoriesInConfiguredForm(configured map[string]*gravitee.Category, categories []string) []string {
+	configuredValues := make(map[string]string, 2*len(configured))
+	for value, category := range configured {
+		configuredValues[value] = value
+		if category != nil {
+			configuredValues[category.ID] = value
+			configuredValues[category.Key] = value
+		}
+	}
+
+	result := make([]string, 0, len(categories))
+	for _, category := range categories {
+		if value, ok := configuredValues[category]; ok {
+			result = append(result, value)
+			continue
+		}
+		result = append(result, category)
+	}
+
+	return result
+}
+
+// expandConfigurationMap turns a map of strings into a plugin configuration: dotted keys such as
+// "consumer.enabled" become nested objects, and values that are valid JSON are decoded so numbers,
+// booleans, arrays and objects keep their type. Other values are sent as plain strings
+func expandConfigurationMap(raw map[string]interface{}) map[string]interface{} {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	config := make(map[string]interface{})
+	for key, value := range raw {
+		parts := strings.Split(key, ".")
+
+		parent := config
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := parent[part].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				parent[part] = child
+			}
+			parent = child
+		}
+
+		parent[parts[len(parts)-1]] = expandConfigurationValue(value.(string))
+	}
+
+	return config
+}
+
+func expandConfigurationValue(value string) interface{} {
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return value
+	}
+
+	return decoded
+}
+
+// flattenConfigurationMap turns a plugin configuration into a map of strings, nested objects
+// giving dotted keys and other values being encoded as JSON. Strings are kept as is, unless
+// they are valid JSON themselves and are quoted so they are sent back as strings
+func flattenConfigurationMap(config map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	flattenConfigurationInto(result, "", config)
+	return result
+}
+
+func flattenConfigurationInto(result map[string]interface{}, prefix string, config map[string]interface{}) {
+	for key, value := range config {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		switch v := value.(type) {
+		case nil:
+			continue
+		case map[string]interface{}:
+			flattenConfigurationInto(result, key, v)
+		case string:
+			if !json.Valid([]byte(v)) {
+				result[key] = v
+				continue
+			}
+
+			encoded, _ := json.Marshal(v)
+			result[key] = string(encoded)
+		default:
+			encoded, _ := json.Marshal(v)
+			result[key] = string(encoded)
+		}
+	}
+}
+
+// apiTimestamp converts a date returned by the management API into a timestamp in milliseconds
+func apiTimestamp(date string) int {
+	t, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return 0
+	}
+
+	return int(t.UnixMilli())
+}
\ No newline at end of file
@@ -0,0 +1,117 @@
+// resource_gravitee_api_deployment.go
+package gravitee
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceGraviteeAPIDeployment deploys an API once per apply, batching the changes made to the API,
+// its plans and its pages into a single deployment. It pairs with batch_deployments on gravitee_api.
+// The resource ID is the API ID.
+func resourceGraviteeAPIDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeAPIDeploymentCreate,
+		ReadContext:   resourceGraviteeAPIDeploymentRead,
+		UpdateContext: resourceGraviteeAPIDeploymentUpdate,
+		DeleteContext: resourceGraviteeAPIDeploymentDelete,
+		CustomizeDiff: resourceGraviteeAPIDeploymentCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the API to deploy",
+			},
+			"deployment_label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Label of the deployments shown in the history of the API",
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Arbitrary values, such as the updated_at of the API and its plans, redeploying the API when they change",
+			},
+			"out_of_sync": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the definition deployed on the gateways differs from the current one",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+// resourceGraviteeAPIDeploymentCustomizeDiff plans a deployment when the API changed outside of Terraform
+func resourceGraviteeAPIDeploymentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" && d.Get("out_of_sync").(bool) {
+		return d.SetNewComputed("out_of_sync")
+	}
+
+	return nil
+}
+
+func resourceGraviteeAPIDeploymentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	apiID := d.Get("api_id").(string)
+
+	err := client.DeployAPI(apiID, d.Get("deployment_label").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(apiID)
+
+	return resourceGraviteeAPIDeploymentRead(ctx, d, m)
+}
+
+func resourceGraviteeAPIDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	api, err := client.GetAPI(d.Get("api_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if api == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("out_of_sync", apiOutOfSync(api))
+
+	return nil
+}
+
+func resourceGraviteeAPIDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	err := client.DeployAPI(d.Get("api_id").(string), d.Get("deployment_label").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGraviteeAPIDeploymentRead(ctx, d, m)
+}
+
+func resourceGraviteeAPIDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Deployments cannot be undone, they are only removed from the state
+	d.SetId("")
+	return nil
+}
+
+// apiOutOfSync tells whether the gateways serve an older definition than the current one of the API
+func apiOutOfSync(api *gravitee.API) bool {
+	return api.DeploymentState == "NEED_REDEPLOY"
+}
\ No newline at end of file
@@ -0,0 +1,602 @@
+// resource_gravitee_api_page.go
+package gravitee
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGraviteeAPIPage() *schema.Resource {
+	resourceSchema := pageSchema([]string{"MARKDOWN", "SWAGGER", "ASYNCAPI", "FOLDER", "LINK"})
+	resourceSchema["api_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "ID of the API this page belongs to",
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceGraviteeAPIPageCreate,
+		ReadContext:   resourceGraviteeAPIPageRead,
+		UpdateContext: resourceGraviteeAPIPageUpdate,
+		DeleteContext: resourceGraviteeAPIPageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGraviteeAPIPageImport,
+		},
+		CustomizeDiff: resourceGraviteeAPIPageCustomizeDiff,
+		Schema:        resourceSchema,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+// pageSchema returns the attributes shared by API pages and portal pages
+func pageSchema(pageTypes []string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the page",
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(pageTypes, false),
+			Description:  fmt.Sprintf("Type of the page (%s)", strings.Join(pageTypes, ", ")),
+		},
+		"content": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			StateFunc:     hashPageContent,
+			ConflictsWith: []string{"source"},
+			Description:   "Content of the page, inline or read with file(). The target URL or page ID for LINK pages. Only its SHA-256 hash is kept in the state",
+		},
+		"source": pageSourceSchema(),
+		"parent_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "ID of the folder containing the page",
+		},
+		"order": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     0,
+			Description: "Position of the page in its folder",
+		},
+		"published": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the page is published in the portal",
+		},
+		"visibility": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "PUBLIC",
+			ValidateFunc: validation.StringInSlice([]string{"PUBLIC", "PRIVATE"}, false),
+			Description:  "Visibility of the page (PUBLIC, PRIVATE)",
+		},
+		"homepage": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the page is the homepage of the API documentation",
+		},
+		"configuration": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Page configuration, such as the viewer of SWAGGER pages or the resourceType of LINK pages. Defaults are filled in by Gravitee",
+		},
+		"access_controls": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Groups and roles the page is restricted to",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"reference_id": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "ID of the group or name of the role",
+					},
+					"reference_type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"GROUP", "ROLE"}, false),
+						Description:  "Type of the reference (GROUP, ROLE)",
+					},
+				},
+			},
+		},
+		"excluded_access_controls": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the access controls list the groups and roles excluded from the page instead",
+		},
+	}
+}
+
+// hashPageContent keeps page contents out of the state, changes are detected on their hash
+func hashPageContent(v interface{}) string {
+	content := v.(string)
+	if content == "" {
+		return ""
+	}
+
+	hash := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(hash[:])
+}
+
+// pageFetcherTypes maps the source types to the fetcher plugins of Gravitee
+var pageFetcherTypes = map[string]string{
+	"GIT":    "git-fetcher",
+	"GITHUB": "github-fetcher",
+	"GITLAB": "gitlab-fetcher",
+	"HTTP":   "http-fetcher",
+}
+
+// pageSourceSchema is the fetcher block shared by pages and page imports
+func pageSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Fetcher retrieving the content from a repository or a URL instead of inline content",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"GIT", "GITHUB", "GITLAB", "HTTP"}, false),
+					Description:  "Type of the fetcher (GIT, GITHUB, GITLAB, HTTP)",
+				},
+				"url": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					Description:  "API URL for GITHUB and GITLAB, defaulting to the public instances. URL of the file for HTTP",
+				},
+				"repository": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Repository as owner/name for GITHUB, namespace/project for GITLAB, or clone URL for GIT",
+				},
+				"branch": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Branch or tag to fetch",
+				},
+				"file_path": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path of the file in the repository, or of the folder for page imports",
+				},
+				"username": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Username owning the token for GITHUB",
+				},
+				"token_wo": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					WriteOnly:   true,
+					Description: "Personal or private access token for GITHUB and GITLAB. Write-only, never stored in the state",
+				},
+				"token_wo_version": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Version of token_wo, change it to send a new token",
+				},
+				"fetch_cron": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateFetchCron,
+					Description:  "Cron expression scheduling automatic fetches, such as 0 */10 * * * *. Fetched on changes only when empty",
+				},
+			},
+		},
+	}
+}
+
+// validatePageSource checks that the fetcher has the attributes its type requires
+func validatePageSource(d *schema.ResourceDiff) error {
+	sources := d.Get("source").([]interface{})
+	if len(sources) == 0 || sources[0] == nil {
+		return nil
+	}
+
+	// url is computed, so the configuration tells apart a missing url from one known at apply only
+	source, err := cty.GetAttrPath("source").IndexInt(0).Apply(d.GetRawConfig())
+	if err != nil || !source.IsKnown() || source.IsNull() {
+		return nil
+	}
+
+	return checkPageSource(sources[0].(map[string]interface{})["type"].(string), source.GetAttr("url"), source.GetAttr("repository"))
+}
+
+// validateFetchCron checks that a value is empty or a cron expression with six fields, seconds first
+func validateFetchCron(v interface{}, k string) (ws []string, errs []error) {
+	fetchCron := v.(string)
+	if fetchCron == "" {
+		return ws, errs
+	}
+
+	fields := strings.Fields(fetchCron)
+	if len(fields) != 6 {
+		errs = append(errs, fmt.Errorf("%q must be a cron expression with six fields, seconds first (e.g. 0 */10 * * * *), got %q", k, fetchCron))
+		return ws, errs
+	}
+
+	for _, field := range fields {
+		if !cronFieldPattern.MatchString(field) {
+			errs = append(errs, fmt.Errorf("%q has an invalid cron field %q", k, field))
+		}
+	}
+
+	return ws, errs
+}
+
+var cronFieldPattern = regexp.MustCompile(`^[0-9A-Za-z*?/,#-]+$`)
+
+// checkPageSource checks the configured url and repository of a fetcher, unknown values are skipped
+func checkPageSource(sourceType string, url cty.Value, repository cty.Value) error {
+	switch sourceType {
+	case "HTTP":
+		if url.IsKnown() && rawConfigString(url) == "" {
+			return fmt.Errorf("source.0.url is required for HTTP sources")
+		}
+	case "GITHUB", "GITLAB":
+		if repository.IsKnown() && !strings.Contains(rawConfigString(repository), "/") {
+			return fmt.Errorf("source.0.repository must be owner/name for GITHUB and namespace/project for GITLAB sources, got %q", rawConfigString(repository))
+		}
+	case "GIT":
+		if repository.IsKnown() && rawConfigString(repository) == "" {
+			return fmt.Errorf("source.0.repository is required for GIT sources")
+		}
+	}
+
+	return nil
+}
+
+// resourceGraviteeAPIPageCustomizeDiff checks that the attributes match the page type
+func resourceGraviteeAPIPageCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if err := validatePageSource(d); err != nil {
+		return err
+	}
+
+	switch d.Get("type").(string) {
+	case "FOLDER":
+		if d.NewValueKnown("content") && d.Get("content").(string) != "" {
+			return fmt.Errorf("content cannot be set on FOLDER pages")
+		}
+		if d.Get("homepage").(bool) {
+			return fmt.Errorf("a FOLDER page cannot be the homepage")
+		}
+	case "LINK":
+		if d.NewValueKnown("content") && d.Get("content").(string) == "" {
+			return fmt.Errorf("content is required on LINK pages")
+		}
+	}
+
+	return nil
+}
+
+func resourceGraviteeAPIPageImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <api_id>/<page_id>", d.Id())
+	}
+
+	d.Set("api_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceGraviteeAPIPageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	apiID := d.Get("api_id").(string)
+
+	page, diags := expandPage(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	createdPage, err := client.CreatePage(apiID, page)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdPage.ID)
+
+	return resourceGraviteeAPIPageRead(ctx, d, m)
+}
+
+func resourceGraviteeAPIPageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	apiID := d.Get("api_id").(string)
+
+	page, err := client.GetPage(apiID, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if page == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Flatten the Page object and set to ResourceData
+	if err := flattenPage(d, page); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGraviteeAPIPageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	apiID := d.Get("api_id").(string)
+
+	page, diags := expandPage(d)
+	if diags.HasError() {
+		return diags
+	}
+	page.ID = d.Id()
+
+	err := client.UpdatePage(apiID, page)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Refresh the content right away instead of waiting for the next scheduled fetch
+	if page.Source != nil && d.HasChange("source") {
+		err = client.FetchPage(apiID, page.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGraviteeAPIPageRead(ctx, d, m)
+}
+
+func resourceGraviteeAPIPageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	apiID := d.Get("api_id").(string)
+
+	err := client.DeletePage(apiID, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper functions for expanding and flattening Page objects
+func expandPage(d *schema.ResourceData) (*gravitee.Page, diag.Diagnostics) {
+	content, diags := pageContent(d)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	page := &gravitee.Page{
+		Name:                   d.Get("name").(string),
+		Type:                   d.Get("type").(string),
+		Content:                content,
+		ParentID:               d.Get("parent_id").(string),
+		Order:                  d.Get("order").(int),
+		Published:              d.Get("published").(bool),
+		Visibility:             d.Get("visibility").(string),
+		Homepage:               d.Get("homepage").(bool),
+		ExcludedAccessControls: d.Get("excluded_access_controls").(bool),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok {
+		configuration := make(map[string]string)
+		for key, value := range v.(map[string]interface{}) {
+			configuration[key] = value.(string)
+		}
+		page.Configuration = configuration
+	}
+
+	accessControls := make([]gravitee.PageAccessControl, 0)
+	for _, v := range d.Get("access_controls").(*schema.Set).List() {
+		accessControlMap := v.(map[string]interface{})
+		accessControls = append(accessControls, gravitee.PageAccessControl{
+			ReferenceID:   accessControlMap["reference_id"].(string),
+			ReferenceType: accessControlMap["reference_type"].(string),
+		})
+	}
+	page.AccessControls = accessControls
+
+	if v, ok := d.GetOk("source"); ok {
+		token, diags := pageSourceToken(d)
+		if diags.HasError() {
+			return nil, diags
+		}
+		page.Source = expandPageSource(v.([]interface{})[0].(map[string]interface{}), token)
+	}
+
+	return page, nil
+}
+
+// pageContent reads the content from the configuration, the plan and the state only hold its hash
+func pageContent(d *schema.ResourceData) (string, diag.Diagnostics) {
+	content, diags := d.GetRawConfigAt(cty.GetAttrPath("content"))
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return rawConfigString(content), nil
+}
+
+// pageSourceToken reads the write-only token from the configuration, it is never in the plan or the state
+func pageSourceToken(d *schema.ResourceData) (string, diag.Diagnostics) {
+	token, diags := d.GetRawConfigAt(cty.GetAttrPath("source").IndexInt(0).GetAttr("token_wo"))
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return rawConfigString(token), nil
+}
+
+// rawConfigString returns a string of the configuration, or an empty string when it is not set
+func rawConfigString(value cty.Value) string {
+	if !value.IsKnown() || value.IsNull() || !value.Type().Equals(cty.String) {
+		return ""
+	}
+
+	return value.AsString()
+}
+
+func expandPageSource(sourceMap map[string]interface{}, token string) *gravitee.PageSource {
+	sourceType := sourceMap["type"].(string)
+	url := sourceMap["url"].(string)
+	repository := sourceMap["repository"].(string)
+	fetchCron := sourceMap["fetch_cron"].(string)
+
+	configuration := map[string]interface{}{
+		"fetchCron": fetchCron,
+		"autoFetch": fetchCron != "",
+	}
+
+	switch sourceType {
+	case "GITHUB":
+		if url == "" {
+			url = "https://api.github.com"
+		}
+		owner, name, _ := strings.Cut(repository, "/")
+		configuration["githubUrl"] = url
+		configuration["owner"] = owner
+		configuration["repository"] = name
+		configuration["branchOrTag"] = sourceMap["branch"].(string)
+		configuration["filepath"] = sourceMap["file_path"].(string)
+		configuration["username"] = sourceMap["username"].(string)
+		configuration["personalAccessToken"] = token
+	case "GITLAB":
+		if url == "" {
+			url = "https://gitlab.com/api/v4"
+		}
+		separator := strings.LastIndex(repository, "/")
+		configuration["gitlabUrl"] = url
+		configuration["namespace"] = repository[:max(separator, 0)]
+		configuration["project"] = repository[separator+1:]
+		configuration["branchOrTag"] = sourceMap["branch"].(string)
+		configuration["filepath"] = sourceMap["file_path"].(string)
+		configuration["privateToken"] = token
+		configuration["apiVersion"] = "V4"
+	case "GIT":
+		configuration["repository"] = repository
+		configuration["branchOrTag"] = sourceMap["branch"].(string)
+		configuration["path"] = sourceMap["file_path"].(string)
+	case "HTTP":
+		configuration["url"] = url
+	}
+
+	return &gravitee.PageSource{
+		Type:          pageFetcherTypes[sourceType],
+		Configuration: configuration,
+	}
+}
+
+// flattenPageSource keeps the token version from the state, the token itself is never read back
+func flattenPageSource(d *schema.ResourceData, source *gravitee.PageSource) []interface{} {
+	if source == nil {
+		return nil
+	}
+
+	configuration := func(key string) string {
+		value, _ := source.Configuration[key].(string)
+		return value
+	}
+
+	sourceMap := map[string]interface{}{
+		"fetch_cron":       configuration("fetchCron"),
+		"token_wo_version": d.Get("source.0.token_wo_version").(int),
+	}
+
+	for sourceType, fetcherType := range pageFetcherTypes {
+		if fetcherType == source.Type {
+			sourceMap["type"] = sourceType
+		}
+	}
+
+	switch source.Type {
+	case "github-fetcher":
+		sourceMap["url"] = configuration("githubUrl")
+		sourceMap["repository"] = configuration("owner") + "/" + configuration("repository")
+		sourceMap["branch"] = configuration("branchOrTag")
+		sourceMap["file_path"] = configuration("filepath")
+		sourceMap["username"] = configuration("username")
+	case "gitlab-fetcher":
+		sourceMap["url"] = configuration("gitlabUrl")
+		sourceMap["repository"] = configuration("namespace") + "/" + configuration("project")
+		sourceMap["branch"] = configuration("branchOrTag")
+		sourceMap["file_path"] = configuration("filepath")
+	case "git-fetcher":
+		sourceMap["repository"] = configuration("repository")
+		sourceMap["branch"] = configuration("branchOrTag")
+		sourceMap["file_path"] = configuration("path")
+	case "http-fetcher":
+		sourceMap["url"] = configuration("url")
+	}
+
+	return []interface{}{sourceMap}
+}
+
+func flattenPage(d *schema.ResourceData, page *gravitee.Page) error {
+	d.Set("name", page.Name)
+	d.Set("type", page.Type)
+	d.Set("parent_id", page.ParentID)
+	d.Set("order", page.Order)
+	d.Set("published", page.Published)
+	d.Set("visibility", page.Visibility)
+	d.Set("homepage", page.Homepage)
+	d.Set("excluded_access_controls", page.ExcludedAccessControls)
+
+	// Fetched contents are owned by the source
+	if page.Source == nil {
+		d.Set("content", hashPageContent(page.Content))
+	}
+
+	if err := d.Set("configuration", page.Configuration); err != nil {
+		return err
+	}
+
+	if err := d.Set("source", flattenPageSource(d, page.Source)); err != nil {
+		return err
+	}
+
+	accessControls := make([]interface{}, len(page.AccessControls))
+	for i, accessControl := range page.AccessControls {
+		accessControls[i] = map[string]interface{}{
+			"reference_id":   accessControl.ReferenceID,
+			"reference_type": accessControl.ReferenceType,
+		}
+	}
+	if err := d.Set("access_controls", accessControls); err != nil {
+		return err
+	}
+
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,143 @@
+// resource_gravitee_api_page_test.go
+package gravitee
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestPageContentHashRoundTrip(t *testing.T) {
+	content := "# Getting started\n\nCall the API with your key.\n"
+
+	if got := rawConfigString(cty.StringVal(content)); got != content {
+		t.Errorf("rawConfigString() = %q, want the configured content", got)
+	}
+
+	// Reading the page back gives the hash of its content
+	flattened := schema.TestResourceDataRaw(t, pageSchema([]string{"MARKDOWN"}), map[string]interface{}{})
+	if err := flattenPage(flattened, &gravitee.Page{Name: "Getting started", Type: "MARKDOWN", Content: content}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The state only holds the hash, which matches the configuration through the StateFunc
+	stateFunc := pageSchema([]string{"MARKDOWN"})["content"].StateFunc
+	if got := flattened.Get("content").(string); got == content || got != stateFunc(content) {
+		t.Errorf("flattened content = %q, want the hash of the content", got)
+	}
+}
+
+func TestRawConfigString(t *testing.T) {
+	cases := []struct {
+		value cty.Value
+		want  string
+	}{
+		{value: cty.StringVal("secret"), want: "secret"},
+		{value: cty.NullVal(cty.String), want: ""},
+		{value: cty.UnknownVal(cty.String), want: ""},
+		{value: cty.DynamicVal, want: ""},
+	}
+
+	for _, c := range cases {
+		if got := rawConfigString(c.value); got != c.want {
+			t.Errorf("rawConfigString(%#v) = %q, want %q", c.value, got, c.want)
+		}
+	}
+
+	if hashPageContent("") != "" {
+		t.Error("expected an empty content to have an empty hash")
+	}
+}
+
+func TestCheckPageSource(t *testing.T) {
+	unknown := cty.UnknownVal(cty.String)
+	unset := cty.NullVal(cty.String)
+
+	cases := []struct {
+		sourceType string
+		url        cty.Value
+		repository cty.Value
+		wantErr    bool
+	}{
+		{sourceType: "HTTP", url: cty.StringVal("https://docs.example.com/openapi.yaml"), repository: unset},
+		{sourceType: "HTTP", url: unknown, repository: unset},
+		{sourceType: "HTTP", url: unset, repository: unset, wantErr: true},
+		{sourceType: "GITHUB", url: unset, repository: cty.StringVal("acme/docs")},
+		{sourceType: "GITHUB", url: unset, repository: cty.StringVal("docs"), wantErr: true},
+		{sourceType: "GITLAB", url: unset, repository: cty.StringVal("acme/platform/docs")},
+		{sourceType: "GITLAB", url: unset, repository: unset, wantErr: true},
+		{sourceType: "GITLAB", url: unset, repository: unknown},
+		{sourceType: "GIT", url: unset, repository: cty.StringVal("https://git.example.com/docs.git")},
+		{sourceType: "GIT", url: unset, repository: unset, wantErr: true},
+	}
+
+	for _, c := range cases {
+		err := checkPageSource(c.sourceType, c.url, c.repository)
+		if (err != nil) != c.wantErr {
+			t.Errorf("checkPageSource(%q, %#v, %#v) returned error %v, want error: %t", c.sourceType, c.url, c.repository, err, c.wantErr)
+		}
+	}
+}
+
+func TestPageSourceRoundTrip(t *testing.T) {
+	sources := []map[string]interface{}{
+		{"type": "GITHUB", "repository": "acme/docs", "branch": "main", "file_path": "docs/index.md", "username": "bot"},
+		{"type": "GITHUB", "url": "https://github.example.com/api/v3", "repository": "acme/docs", "branch": "main", "file_path": "README.md"},
+		{"type": "GITLAB", "repository": "acme/platform/docs", "branch": "v2", "file_path": "index.md", "fetch_cron": "0 */10 * * * *"},
+		{"type": "GIT", "repository": "https://git.example.com/docs.git", "branch": "main", "file_path": "index.md"},
+		{"type": "HTTP", "url": "https://docs.example.com/openapi.yaml"},
+	}
+
+	for _, source := range sources {
+		d := schema.TestResourceDataRaw(t, pageSchema([]string{"MARKDOWN"}), map[string]interface{}{
+			"name":   "Docs",
+			"type":   "MARKDOWN",
+			"source": []interface{}{source},
+		})
+
+		sent := expandPageSource(d.Get("source").([]interface{})[0].(map[string]interface{}), "token")
+
+		if err := d.Set("source", flattenPageSource(d, sent)); err != nil {
+			t.Fatal(err)
+		}
+
+		// Defaults filled in for the public instances are kept in the state and sent back unchanged
+		resent := expandPageSource(d.Get("source").([]interface{})[0].(map[string]interface{}), "token")
+		if !reflect.DeepEqual(resent, sent) {
+			t.Errorf("%s source changed after a round trip: got %#v, want %#v", source["type"], resent, sent)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, pageSchema([]string{"MARKDOWN"}), map[string]interface{}{})
+	flattened := flattenPageSource(d, &gravitee.PageSource{
+		Type:          "github-fetcher",
+		Configuration: map[string]interface{}{"githubUrl": "https://api.github.com", "owner": "acme", "repository": "docs"},
+	})
+	if url := flattened[0].(map[string]interface{})["url"]; url != "https://api.github.com" {
+		t.Errorf("expected the default GitHub URL to be read back, got %q", url)
+	}
+}
+
+func TestValidateFetchCron(t *testing.T) {
+	cases := []struct {
+		fetchCron string
+		wantErr   bool
+	}{
+		{fetchCron: ""},
+		{fetchCron: "0 */10 * * * *"},
+		{fetchCron: "0 0 8 * * MON-FRI"},
+		{fetchCron: "0 0 12 ? * 6#3"},
+		{fetchCron: "*/10 * * * *", wantErr: true},
+		{fetchCron: "every ten minutes", wantErr: true},
+		{fetchCron: "0 0 8 * * MON;FRI", wantErr: true},
+	}
+
+	for _, c := range cases {
+		_, errs := validateFetchCron(c.fetchCron, "fetch_cron")
+		if (len(errs) > 0) != c.wantErr {
+			t.Errorf("validateFetchCron(%q) returned %v, want error: %t", c.fetchCron, errs, c.wantErr)
+		}
+	}
+}
\ No newline at end of file
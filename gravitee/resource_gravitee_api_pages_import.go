@@ -0,0 +1,262 @@
+// resource_gravitee_api_pages_import.go
+package gravitee
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGraviteeAPIPagesImport() *schema.Resource {
+	source := pageSourceSchema()
+	source.Optional = false
+	source.Required = true
+	source.Description = "Fetcher retrieving the folder tree from a repository"
+
+	return &schema.Resource{
+		CreateContext: resourceGraviteeAPIPagesImportCreate,
+		ReadContext:   resourceGraviteeAPIPagesImportRead,
+		UpdateContext: resourceGraviteeAPIPagesImportUpdate,
+		DeleteContext: resourceGraviteeAPIPagesImportDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGraviteeAPIPagesImportImport,
+		},
+		CustomizeDiff: resourceGraviteeAPIPagesImportCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the API the pages are imported into",
+			},
+			"source": source,
+			"published": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the imported pages are published in the portal",
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "PUBLIC",
+				ValidateFunc: validation.StringInSlice([]string{"PUBLIC", "PRIVATE"}, false),
+				Description:  "Visibility of the imported pages (PUBLIC, PRIVATE)",
+			},
+			"page_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "IDs of the imported pages",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+// resourceGraviteeAPIPagesImportCustomizeDiff checks the fetcher attributes
+func resourceGraviteeAPIPagesImportCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return validatePageSource(d)
+}
+
+// resourceGraviteeAPIPagesImportImport imports a tree from its ROOT page, along with the pages
+// below it so they are deleted with the resource
+func resourceGraviteeAPIPagesImportImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*gravitee.Client)
+
+	if _, err := resourceGraviteeAPIPageImport(ctx, d, m); err != nil {
+		return nil, err
+	}
+
+	pages, err := client.ListPages(d.Get("api_id").(string), "")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("page_ids", pageTreeIDs(pages, d.Id()))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceGraviteeAPIPagesImportCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	apiID := d.Get("api_id").(string)
+
+	pagesImport, diags := expandPagesImport(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	pages, err := client.ImportPages(apiID, pagesImport, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rootPageID := importRootPageID(pages)
+	if rootPageID == "" {
+		return diag.Errorf("no page was imported from the source")
+	}
+
+	// The ROOT page holds the source of the whole tree
+	d.SetId(rootPageID)
+	d.Set("page_ids", importPageIDs(pages))
+
+	return resourceGraviteeAPIPagesImportRead(ctx, d, m)
+}
+
+func resourceGraviteeAPIPagesImportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	apiID := d.Get("api_id").(string)
+
+	rootPage, err := client.GetPage(apiID, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if rootPage == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("published", rootPage.Published)
+	d.Set("visibility", rootPage.Visibility)
+
+	if err := d.Set("source", flattenPageSource(d, rootPage.Source)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Drop the pages deleted outside of Terraform
+	pageIDs := []string{rootPage.ID}
+	for _, v := range d.Get("page_ids").([]interface{}) {
+		pageID := v.(string)
+		if pageID == rootPage.ID {
+			continue
+		}
+
+		page, err := client.GetPage(apiID, pageID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if page != nil {
+			pageIDs = append(pageIDs, pageID)
+		}
+	}
+
+	if err := d.Set("page_ids", pageIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGraviteeAPIPagesImportUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	apiID := d.Get("api_id").(string)
+
+	pagesImport, diags := expandPagesImport(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	pages, err := client.ImportPages(apiID, pagesImport, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("page_ids", importPageIDs(pages))
+
+	return resourceGraviteeAPIPagesImportRead(ctx, d, m)
+}
+
+func resourceGraviteeAPIPagesImportDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	apiID := d.Get("api_id").(string)
+
+	// Delete the children first, pages removed along with their folder are skipped
+	pageIDs := d.Get("page_ids").([]interface{})
+	for i := len(pageIDs) - 1; i >= 0; i-- {
+		pageID := pageIDs[i].(string)
+
+		page, err := client.GetPage(apiID, pageID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if page == nil {
+			continue
+		}
+
+		err = client.DeletePage(apiID, pageID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper functions for expanding Pages Import objects
+func expandPagesImport(d *schema.ResourceData) (*gravitee.PagesImport, diag.Diagnostics) {
+	token, diags := pageSourceToken(d)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &gravitee.PagesImport{
+		Type:           "ROOT",
+		Published:      d.Get("published").(bool),
+		Visibility:     d.Get("visibility").(string),
+		Source:         expandPageSource(d.Get("source").([]interface{})[0].(map[string]interface{}), token),
+		Configuration:  map[string]string{},
+		AccessControls: []gravitee.PageAccessControl{},
+	}, nil
+}
+
+func importRootPageID(pages []gravitee.Page) string {
+	for _, page := range pages {
+		if page.Type == "ROOT" {
+			return page.ID
+		}
+	}
+
+	if len(pages) > 0 {
+		return pages[0].ID
+	}
+
+	return ""
+}
+
+func importPageIDs(pages []gravitee.Page) []string {
+	pageIDs := make([]string, len(pages))
+	for i, page := range pages {
+		pageIDs[i] = page.ID
+	}
+	return pageIDs
+}
+
+// pageTreeIDs returns the ID of the root page followed by the pages whose parent chain leads to it,
+// parents before their children
+func pageTreeIDs(pages []gravitee.Page, rootPageID string) []string {
+	children := make(map[string][]string)
+	for _, page := range pages {
+		if page.ParentID != "" && page.ID != rootPageID {
+			children[page.ParentID] = append(children[page.ParentID], page.ID)
+		}
+	}
+
+	pageIDs := []string{rootPageID}
+	for i := 0; i < len(pageIDs); i++ {
+		pageIDs = append(pageIDs, children[pageIDs[i]]...)
+	}
+
+	return pageIDs
+}
\ No newline at end of file
@@ -0,0 +1,23 @@
+// resource_gravitee_api_pages_import_test.go
+package gravitee
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPageTreeIDs(t *testing.T) {
+	pages := []gravitee.Page{
+		{ID: "guide", Type: "MARKDOWN", ParentID: "docs"},
+		{ID: "root", Type: "ROOT"},
+		{ID: "docs", Type: "FOLDER", ParentID: "root"},
+		{ID: "readme", Type: "MARKDOWN", ParentID: "root"},
+		{ID: "other", Type: "MARKDOWN"},
+		{ID: "other-child", Type: "MARKDOWN", ParentID: "other"},
+	}
+
+	want := []string{"root", "docs", "readme", "guide"}
+	if got := pageTreeIDs(pages, "root"); !reflect.DeepEqual(got, want) {
+		t.Errorf("pageTreeIDs() = %v, want %v", got, want)
+	}
+}
\ No newline at end of file
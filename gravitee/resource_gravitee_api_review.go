@@ -0,0 +1,149 @@
+// resource_gravitee_api_review.go
+package gravitee
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceGraviteeAPIReview gives a review decision on an API, optionally asking for the review first.
+// A review is an event: destroying the resource only removes it from the state.
+// The resource ID is the API ID.
+func resourceGraviteeAPIReview() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeAPIReviewCreate,
+		ReadContext:   resourceGraviteeAPIReviewRead,
+		DeleteContext: resourceGraviteeAPIReviewDelete,
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the API to review",
+			},
+			"decision": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ACCEPT", "REJECT"}, false),
+				Description:  "Review decision (ACCEPT, REJECT)",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Comment sent with the decision",
+			},
+			"request_review": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Whether to ask for the review first when the API is in DRAFT or REQUEST_FOR_CHANGES",
+			},
+			"request_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Message sent with the review request",
+			},
+			"workflow_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Review workflow state of the API (DRAFT, IN_REVIEW, REQUEST_FOR_CHANGES, REVIEW_OK)",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceGraviteeAPIReviewCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	apiID := d.Get("api_id").(string)
+
+	api, err := client.GetAPI(apiID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if api == nil {
+		return diag.Errorf("API %s not found", apiID)
+	}
+
+	workflowState, err := apiWorkflowState(api)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("request_review").(bool) && apiReviewRequestable(workflowState) {
+		err = client.AskForAPIReview(apiID, d.Get("request_message").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		workflowState = "IN_REVIEW"
+	}
+
+	if workflowState != "IN_REVIEW" {
+		return diag.Errorf("API %s is %s, it must be IN_REVIEW to be reviewed. Set request_review to ask for the review first", apiID, workflowState)
+	}
+
+	if d.Get("decision").(string) == "ACCEPT" {
+		err = client.AcceptAPIReview(apiID, d.Get("comment").(string))
+	} else {
+		err = client.RejectAPIReview(apiID, d.Get("comment").(string))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(apiID)
+
+	return resourceGraviteeAPIReviewRead(ctx, d, m)
+}
+
+func resourceGraviteeAPIReviewRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	api, err := client.GetAPI(d.Get("api_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if api == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("workflow_state", api.WorkflowState)
+
+	return nil
+}
+
+func resourceGraviteeAPIReviewDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Reviews cannot be undone, they are only removed from the state
+	d.SetId("")
+	return nil
+}
+
+// apiWorkflowState returns the review workflow state of an API. Gravitee only tracks it when
+// API review is enabled in the environment settings.
+func apiWorkflowState(api *gravitee.API) (string, error) {
+	if api.WorkflowState == "" {
+		return "", fmt.Errorf("API %s has no review workflow state, API review is not enabled in the environment settings", api.ID)
+	}
+
+	return api.WorkflowState, nil
+}
+
+// apiReviewRequestable tells whether a review can be asked for an API in the workflow state
+func apiReviewRequestable(workflowState string) bool {
+	return workflowState == "DRAFT" || workflowState == "REQUEST_FOR_CHANGES"
+}
\ No newline at end of file
@@ -0,0 +1,35 @@
+// resource_gravitee_api_review_test.go
+package gravitee
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAPIWorkflowState(t *testing.T) {
+	workflowState, err := apiWorkflowState(&gravitee.API{ID: "a1", WorkflowState: "IN_REVIEW"})
+	if err != nil || workflowState != "IN_REVIEW" {
+		t.Errorf("apiWorkflowState() = %q, %v, want IN_REVIEW", workflowState, err)
+	}
+
+	_, err = apiWorkflowState(&gravitee.API{ID: "a1"})
+	if err == nil || !strings.Contains(err.Error(), "not enabled") {
+		t.Errorf("expected an error telling that API review is not enabled, got %v", err)
+	}
+}
+
+func TestAPIReviewRequestable(t *testing.T) {
+	cases := map[string]bool{
+		"DRAFT":               true,
+		"REQUEST_FOR_CHANGES": true,
+		"IN_REVIEW":           false,
+		"REVIEW_OK":           false,
+		"":                    false,
+	}
+
+	for workflowState, want := range cases {
+		if got := apiReviewRequestable(workflowState); got != want {
+			t.Errorf("apiReviewRequestable(%q) = %t, want %t", workflowState, got, want)
+		}
+	}
+}
\ No newline at end of file
@@ -0,0 +1,281 @@
+// resource_gravitee_api_test.go
+package gravitee
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestConfigurationMapRoundTrip(t *testing.T) {
+	raw := map[string]interface{}{
+		"target":                    "https://backend.example.com",
+		"http.connectTimeout":       "3000",
+		"http.keepAlive":            "true",
+		"http.version":              "HTTP_1_1",
+		"ssl.trustAll":              "false",
+		"ssl.hostnameVerifier":      "true",
+		"headers.X-Forwarded-Proto": "https",
+		"headers.X-Debug":           `"true"`,
+		"hosts":                     `["a","b"]`,
+		"proxy":                     `{"enabled":false,"port":8080}`,
+	}
+
+	config := expandConfigurationMap(raw)
+
+	want := map[string]interface{}{
+		"target": "https://backend.example.com",
+		"http": map[string]interface{}{
+			"connectTimeout": float64(3000),
+			"keepAlive":      true,
+			"version":        "HTTP_1_1",
+		},
+		"ssl": map[string]interface{}{
+			"trustAll":         false,
+			"hostnameVerifier": true,
+		},
+		"headers": map[string]interface{}{
+			"X-Forwarded-Proto": "https",
+			"X-Debug":           "true",
+		},
+		"hosts": []interface{}{"a", "b"},
+		"proxy": map[string]interface{}{
+			"enabled": false,
+			"port":    float64(8080),
+		},
+	}
+	if !reflect.DeepEqual(config, want) {
+		t.Fatalf("expandConfigurationMap() = %#v, want %#v", config, want)
+	}
+
+	// Objects given as JSON are read back as dotted keys
+	flattened := flattenConfigurationMap(config)
+	delete(raw, "proxy")
+	raw["proxy.enabled"] = "false"
+	raw["proxy.port"] = "8080"
+	if !reflect.DeepEqual(flattened, raw) {
+		t.Errorf("flattenConfigurationMap() = %#v, want %#v", flattened, raw)
+	}
+
+	// The configuration read back is sent unchanged
+	body, err := json.Marshal(expandConfigurationMap(flattened))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sent, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != string(sent) {
+		t.Errorf("configuration changed after a round trip: got %s, want %s", body, sent)
+	}
+}
+
+func TestFlattenConfigurationMapFromJSON(t *testing.T) {
+	var config map[string]interface{}
+	err := json.Unmarshal([]byte(`{"http":{"connectTimeout":3000,"version":"HTTP_1_1"},"proxy":{"enabled":false},"hosts":["a","b"],"port":"8080","unset":null}`), &config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{
+		"http.connectTimeout": "3000",
+		"http.version":        "HTTP_1_1",
+		"proxy.enabled":       "false",
+		"hosts":               `["a","b"]`,
+		"port":                `"8080"`,
+	}
+	if got := flattenConfigurationMap(config); !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenConfigurationMap() = %#v, want %#v", got, want)
+	}
+
+	// Strings that look like numbers are sent back as strings
+	if port := expandConfigurationMap(want)["port"]; port != "8080" {
+		t.Errorf("expected the port to be sent as a string, got %#v", port)
+	}
+
+	if expandConfigurationMap(map[string]interface{}{}) != nil {
+		t.Error("expected an empty configuration to be omitted")
+	}
+}
+
+func TestAPICategoriesInConfiguredForm(t *testing.T) {
+	configured := map[string]*gravitee.Category{
+		"finance":                              {ID: "9f5c3e0a-0000-4000-8000-000000000001", Key: "finance"},
+		"9f5c3e0a-0000-4000-8000-000000000002": {ID: "9f5c3e0a-0000-4000-8000-000000000002", Key: "retail"},
+		"deleted":                              nil,
+	}
+
+	got := apiCategoriesInConfiguredForm(configured, []string{
+		"9f5c3e0a-0000-4000-8000-000000000001",
+		"retail",
+		"deleted",
+		"added-outside-terraform",
+	})
+	sort.Strings(got)
+
+	want := []string{
+		"9f5c3e0a-0000-4000-8000-000000000002",
+		"added-outside-terraform",
+		"deleted",
+		"finance",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("apiCategoriesInConfiguredForm() = %v, want %v", got, want)
+	}
+}
+
+func TestConfiguredCategories(t *testing.T) {
+	categories := []gravitee.Category{
+		{ID: "9f5c3e0a-0000-4000-8000-000000000001", Key: "finance"},
+		{ID: "9f5c3e0a-0000-4000-8000-000000000002", Key: "retail"},
+	}
+
+	got := configuredCategories([]string{"finance", "9f5c3e0a-0000-4000-8000-000000000002", "deleted"}, categories)
+	want := map[string]*gravitee.Category{
+		"finance":                              &categories[0],
+		"9f5c3e0a-0000-4000-8000-000000000002": &categories[1],
+		"deleted":                              nil,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("configuredCategories() = %v, want %v", got, want)
+	}
+
+	// Values returned as is by the API need no lookup
+	if !containsAll([]string{"finance", "retail"}, []string{"retail"}) {
+		t.Error("expected the configured categories to be found")
+	}
+
+	if containsAll([]string{"9f5c3e0a-0000-4000-8000-000000000001"}, []string{"finance"}) {
+		t.Error("expected a category key not to match its ID")
+	}
+}
+
+func TestAPITimestamp(t *testing.T) {
+	if got := apiTimestamp("2024-03-01T10:00:00.5Z"); got != 1709287200500 {
+		t.Errorf("apiTimestamp() = %d, want 1709287200500", got)
+	}
+
+	if got := apiTimestamp(""); got != 0 {
+		t.Errorf("apiTimestamp(\"\") = %d, want 0", got)
+	}
+}
+
+func TestEndpointRoundTrip(t *testing.T) {
+	endpoint := gravitee.Endpoint{
+		Name:                 "eu",
+		Type:                 "http-proxy",
+		Weight:               2,
+		InheritConfiguration: false,
+		Tenants:              []string{"eu-west"},
+		Configuration: map[string]interface{}{
+			"target": "https://eu.backend.example.com",
+		},
+	}
+
+	flattened := flattenEndpoint(endpoint)
+	flattened["tenants"] = schema.NewSet(schema.HashString, flattened["tenants"].([]interface{}))
+
+	if got := expandEndpoint(flattened); !reflect.DeepEqual(got, endpoint) {
+		t.Errorf("expandEndpoint(flattenEndpoint()) = %#v, want %#v", got, endpoint)
+	}
+}
+
+func TestEndpointTenants(t *testing.T) {
+	endpoint := func(tenants ...interface{}) interface{} {
+		return map[string]interface{}{
+			"tenants": schema.NewSet(schema.HashString, tenants),
+		}
+	}
+
+	groups := []interface{}{
+		map[string]interface{}{
+			"endpoints": []interface{}{endpoint("us-east", "eu-west"), endpoint()},
+		},
+		map[string]interface{}{
+			"endpoints": []interface{}{endpoint("eu-west", ""), nil},
+		},
+		nil,
+	}
+
+	known := func(key string) bool { return true }
+
+	want := []string{"eu-west", "us-east"}
+	if got := endpointTenants(groups, known); !reflect.DeepEqual(got, want) {
+		t.Errorf("endpointTenants() = %v, want %v", got, want)
+	}
+
+	// Tenants that are not known yet are left to the apply
+	unknown := func(key string) bool { return key != "endpoint_groups.0.endpoints.0.tenants" }
+
+	want = []string{"eu-west"}
+	if got := endpointTenants(groups, unknown); !reflect.DeepEqual(got, want) {
+		t.Errorf("endpointTenants() with unknown tenants = %v, want %v", got, want)
+	}
+}
+
+func TestValidateAPILifecycleTransition(t *testing.T) {
+	cases := []struct {
+		from    string
+		to      string
+		wantErr bool
+	}{
+		{from: "", to: "PUBLISHED"},
+		{from: "PUBLISHED", to: ""},
+		{from: "CREATED", to: "PUBLISHED"},
+		{from: "PUBLISHED", to: "PUBLISHED"},
+		{from: "PUBLISHED", to: "UNPUBLISHED"},
+		{from: "UNPUBLISHED", to: "PUBLISHED"},
+		{from: "PUBLISHED", to: "DEPRECATED"},
+		{from: "DEPRECATED", to: "ARCHIVED"},
+		{from: "PUBLISHED", to: "CREATED", wantErr: true},
+		{from: "DEPRECATED", to: "PUBLISHED", wantErr: true},
+		{from: "ARCHIVED", to: "PUBLISHED", wantErr: true},
+		{from: "ARCHIVED", to: "CREATED", wantErr: true},
+		{from: "UNKNOWN", to: "PUBLISHED", wantErr: true},
+	}
+
+	for _, c := range cases {
+		err := validateAPILifecycleTransition(c.from, c.to)
+		if (err != nil) != c.wantErr {
+			t.Errorf("validateAPILifecycleTransition(%q, %q) returned error %v, want error: %t", c.from, c.to, err, c.wantErr)
+		}
+	}
+}
+
+func TestAPIOutOfSync(t *testing.T) {
+	cases := map[string]bool{
+		"DEPLOYED":      false,
+		"NEED_REDEPLOY": true,
+		"":              false,
+	}
+
+	for deploymentState, want := range cases {
+		if got := apiOutOfSync(&gravitee.API{DeploymentState: deploymentState}); got != want {
+			t.Errorf("apiOutOfSync(%q) = %t, want %t", deploymentState, got, want)
+		}
+	}
+}
+
+func TestAPIDefinitionChanged(t *testing.T) {
+	cases := []struct {
+		changedKeys []string
+		want        bool
+	}{
+		{changedKeys: nil, want: false},
+		{changedKeys: []string{"deployment_label", "request_review", "review_message", "batch_deployments"}, want: false},
+		{changedKeys: []string{"out_of_sync", "updated_at", "deployed_at"}, want: false},
+		{changedKeys: []string{"deployment_label", "description"}, want: true},
+		{changedKeys: []string{"endpoint_groups.0.endpoints.0.weight"}, want: true},
+		{changedKeys: []string{"tags.#", "tags.1234"}, want: true},
+	}
+
+	for _, c := range cases {
+		if got := apiDefinitionChanged(c.changedKeys); got != c.want {
+			t.Errorf("apiDefinitionChanged(%v) = %t, want %t", c.changedKeys, got, c.want)
+		}
+	}
+}
\ No newline at end of file
@@ -0,0 +1,508 @@
+// resource_gravitee_application.go
+package gravitee
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGraviteeApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeApplicationCreate,
+		ReadContext:   resourceGraviteeApplicationRead,
+		UpdateContext: resourceGraviteeApplicationUpdate,
+		DeleteContext: resourceGraviteeApplicationDelete,
+		CustomizeDiff: resourceGraviteeApplicationCustomizeDiff,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validateClientCertificateExpiry,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the application",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the application",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Domain of the application",
+			},
+			"picture": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Picture of the application, as a base64 data URI",
+			},
+			"groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "IDs of the groups the application belongs to",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "SIMPLE",
+				ValidateFunc: validation.StringInSlice([]string{"SIMPLE", "BROWSER", "WEB", "NATIVE", "BACKEND_TO_BACKEND"}, false),
+				Description:  "Type of the application (SIMPLE, BROWSER, WEB, NATIVE, BACKEND_TO_BACKEND)",
+			},
+			"settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Settings of a SIMPLE application",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Free-form type of the application (e.g. web, mobile)",
+									},
+									"client_id": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Client ID used to identify the application on OAuth2 and JWT plans",
+									},
+								},
+							},
+						},
+						"oauth": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "OAuth client settings of a BROWSER, WEB, NATIVE or BACKEND_TO_BACKEND application, registered through DCR",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"grant_types": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+										Description: "OAuth grant types (e.g. authorization_code, client_credentials)",
+									},
+									"redirect_uris": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+										Description: "Allowed redirect URIs",
+									},
+								},
+							},
+						},
+						"tls": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "TLS settings used to identify the application on mTLS plans",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_certificate": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "PEM encoded client certificate of the application",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"api_key_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"UNSPECIFIED", "SHARED", "EXCLUSIVE"}, false),
+				Description:  "API key mode of the application (UNSPECIFIED, SHARED, EXCLUSIVE). Cannot be changed once set to SHARED or EXCLUSIVE",
+			},
+			"client_certificate_expiry_warning_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Show a warning during plan when the configured client certificate expires within this number of days",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the application",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Client ID of the application",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Client secret of the application, for DCR-backed applications",
+			},
+			"created_at": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Creation timestamp of the application",
+			},
+			"updated_at": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Last update timestamp of the application",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+// resourceGraviteeApplicationCustomizeDiff checks that the settings match the application type
+// and that a new client certificate is valid
+func resourceGraviteeApplicationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// A certificate already applied is not checked again, so plans keep working once it expires
+	if v, ok := d.GetOk("settings.0.tls.0.client_certificate"); ok && d.HasChange("settings.0.tls.0.client_certificate") && d.NewValueKnown("settings.0.tls.0.client_certificate") {
+		if err := validateClientCertificate(v.(string)); err != nil {
+			return fmt.Errorf("invalid settings.tls.client_certificate: %s", err)
+		}
+	}
+
+	// Gravitee only allows choosing the API key mode once
+	if d.HasChange("api_key_mode") {
+		oldMode, newMode := d.GetChange("api_key_mode")
+		if oldMode.(string) != "" && oldMode.(string) != "UNSPECIFIED" && newMode.(string) != oldMode.(string) {
+			return fmt.Errorf("api_key_mode cannot be changed once set to %s", oldMode)
+		}
+	}
+
+	applicationType := d.Get("type").(string)
+	_, hasOAuth := d.GetOk("settings.0.oauth")
+
+	if applicationType == "SIMPLE" && hasOAuth {
+		return fmt.Errorf("settings.oauth cannot be used with a SIMPLE application")
+	}
+
+	if applicationType != "SIMPLE" && !hasOAuth && d.NewValueKnown("settings") {
+		return fmt.Errorf("settings.oauth is required for a %s application", applicationType)
+	}
+
+	return nil
+}
+
+func resourceGraviteeApplicationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	application := expandApplication(d)
+
+	createdApplication, err := client.CreateApplication(application)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdApplication.ID)
+
+	return resourceGraviteeApplicationRead(ctx, d, m)
+}
+
+func resourceGraviteeApplicationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	application, err := client.GetApplication(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if application == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Flatten the Application object and set to ResourceData
+	if err := flattenApplication(d, application); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGraviteeApplicationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	application := expandApplication(d)
+	application.ID = d.Id()
+
+	err := client.UpdateApplication(application)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGraviteeApplicationRead(ctx, d, m)
+}
+
+func resourceGraviteeApplicationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	err := client.DeleteApplication(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper functions for expanding and flattening Application objects
+func expandApplication(d *schema.ResourceData) *gravitee.Application {
+	applicationType := d.Get("type").(string)
+
+	application := &gravitee.Application{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Domain:      d.Get("domain").(string),
+		Picture:     d.Get("picture").(string),
+		APIKeyMode:  d.Get("api_key_mode").(string),
+		Settings:    &gravitee.ApplicationSettings{},
+	}
+
+	if v, ok := d.GetOk("groups"); ok {
+		groups := make([]string, 0)
+		for _, group := range v.(*schema.Set).List() {
+			groups = append(groups, group.(string))
+		}
+		application.Groups = groups
+	}
+
+	if v, ok := d.GetOk("settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		settings := v.([]interface{})[0].(map[string]interface{})
+
+		if app, ok := settings["app"]; ok && len(app.([]interface{})) > 0 && app.([]interface{})[0] != nil {
+			appSettings := app.([]interface{})[0].(map[string]interface{})
+			application.Settings.App = &gravitee.SimpleApplicationSettings{
+				Type:     appSettings["type"].(string),
+				ClientID: appSettings["client_id"].(string),
+			}
+		}
+
+		if oauth, ok := settings["oauth"]; ok && len(oauth.([]interface{})) > 0 && oauth.([]interface{})[0] != nil {
+			oauthSettings := oauth.([]interface{})[0].(map[string]interface{})
+			application.Settings.OAuth = &gravitee.OAuthClientSettings{
+				GrantTypes:   expandStringList(oauthSettings["grant_types"].([]interface{})),
+				RedirectURIs: expandStringList(oauthSettings["redirect_uris"].([]interface{})),
+			}
+		}
+
+		if tls, ok := settings["tls"]; ok && len(tls.([]interface{})) > 0 && tls.([]interface{})[0] != nil {
+			tlsSettings := tls.([]interface{})[0].(map[string]interface{})
+			application.Settings.TLS = &gravitee.ApplicationTLSSettings{
+				ClientCertificate: tlsSettings["client_certificate"].(string),
+			}
+		}
+	}
+
+	// SIMPLE applications use app settings, the others are registered as OAuth clients
+	if applicationType == "SIMPLE" {
+		application.Settings.OAuth = nil
+		if application.Settings.App == nil {
+			application.Settings.App = &gravitee.SimpleApplicationSettings{}
+		}
+	} else {
+		application.Settings.App = nil
+		if application.Settings.OAuth == nil {
+			application.Settings.OAuth = &gravitee.OAuthClientSettings{}
+		}
+		application.Settings.OAuth.ApplicationType = strings.ToLower(applicationType)
+	}
+
+	return application
+}
+
+func flattenApplication(d *schema.ResourceData, application *gravitee.Application) error {
+	d.Set("name", application.Name)
+	d.Set("description", application.Description)
+	d.Set("domain", application.Domain)
+	d.Set("picture", application.Picture)
+	d.Set("status", application.Status)
+	d.Set("api_key_mode", application.APIKeyMode)
+	d.Set("created_at", application.CreatedAt)
+	d.Set("updated_at", application.UpdatedAt)
+
+	if application.Type != "" {
+		d.Set("type", application.Type)
+	}
+
+	if err := d.Set("groups", application.Groups); err != nil {
+		return err
+	}
+
+	settings := map[string]interface{}{}
+	clientID := ""
+	clientSecret := ""
+
+	// SIMPLE applications always have app settings, only keep them when declared or set
+	if application.Settings != nil && application.Settings.App != nil {
+		app := application.Settings.App
+		if app.Type != "" || app.ClientID != "" || len(d.Get("settings.0.app").([]interface{})) > 0 {
+			settings["app"] = []interface{}{map[string]interface{}{
+				"type":      app.Type,
+				"client_id": app.ClientID,
+			}}
+		}
+		clientID = app.ClientID
+	}
+
+	if application.Settings != nil && application.Settings.OAuth != nil {
+		settings["oauth"] = []interface{}{map[string]interface{}{
+			"grant_types":   application.Settings.OAuth.GrantTypes,
+			"redirect_uris": application.Settings.OAuth.RedirectURIs,
+		}}
+		clientID = application.Settings.OAuth.ClientID
+		clientSecret = application.Settings.OAuth.ClientSecret
+	}
+
+	if application.Settings != nil && application.Settings.TLS != nil && application.Settings.TLS.ClientCertificate != "" {
+		settings["tls"] = []interface{}{map[string]interface{}{
+			"client_certificate": application.Settings.TLS.ClientCertificate,
+		}}
+	}
+
+	if err := d.Set("settings", []interface{}{settings}); err != nil {
+		return err
+	}
+
+	d.Set("client_id", clientID)
+	d.Set("client_secret", clientSecret)
+
+	return nil
+}
+
+func expandStringList(raw []interface{}) []string {
+	result := make([]string, 0, len(raw))
+	for _, v := range raw {
+		result = append(result, v.(string))
+	}
+
+	return result
+}
+
+func flattenStringList(list []string) []interface{} {
+	result := make([]interface{}, len(list))
+	for i, v := range list {
+		result[i] = v
+	}
+
+	return result
+}
+
+// parseClientCertificate decodes the first certificate of a PEM bundle
+func parseClientCertificate(pemCertificate string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(pemCertificate))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found")
+	}
+
+	if block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("expected a CERTIFICATE PEM block, got %s", block.Type)
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// validateClientCertificate checks that a PEM client certificate can be parsed and has not expired
+func validateClientCertificate(pemCertificate string) error {
+	certificate, err := parseClientCertificate(pemCertificate)
+	if err != nil {
+		return err
+	}
+
+	if time.Now().After(certificate.NotAfter) {
+		return fmt.Errorf("certificate expired on %s", certificate.NotAfter.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// validateClientCertificateExpiry warns during plan when the configured client certificate expires
+// within client_certificate_expiry_warning_days
+func validateClientCertificateExpiry(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	if !req.RawConfig.IsKnown() || req.RawConfig.IsNull() {
+		return
+	}
+
+	certificatePath := cty.GetAttrPath("settings").IndexInt(0).GetAttr("tls").IndexInt(0).GetAttr("client_certificate")
+	certificate, err := certificatePath.Apply(req.RawConfig)
+	if err != nil || !certificate.IsKnown() || certificate.IsNull() {
+		return
+	}
+
+	warningDays := req.RawConfig.GetAttr("client_certificate_expiry_warning_days")
+	if !warningDays.IsKnown() {
+		return
+	}
+
+	days := 30
+	if !warningDays.IsNull() {
+		configuredDays, _ := warningDays.AsBigFloat().Int64()
+		days = int(configuredDays)
+	}
+
+	resp.Diagnostics = append(resp.Diagnostics, clientCertificateExpiryWarning(certificate.AsString(), days)...)
+}
+
+// clientCertificateExpiryWarning warns when the certificate has expired or expires within the given number of days.
+// New expired certificates are rejected by validateClientCertificate, this covers the ones already deployed.
+func clientCertificateExpiryWarning(pemCertificate string, days int) diag.Diagnostics {
+	certificate, err := parseClientCertificate(pemCertificate)
+	if err != nil {
+		return nil
+	}
+
+	if !time.Now().Before(certificate.NotAfter) {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  "Application client certificate has expired",
+				Detail:   fmt.Sprintf("The client certificate %q expired on %s. The application cannot call mTLS plans until it is replaced.", certificate.Subject.CommonName, certificate.NotAfter.Format(time.RFC3339)),
+			},
+		}
+	}
+
+	if time.Until(certificate.NotAfter) > time.Duration(days)*24*time.Hour {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Application client certificate is about to expire",
+			Detail:   fmt.Sprintf("The client certificate %q expires on %s. Applications cannot call mTLS plans once it has expired.", certificate.Subject.CommonName, certificate.NotAfter.Format(time.RFC3339)),
+		},
+	}
+}
\ No newline at end of file
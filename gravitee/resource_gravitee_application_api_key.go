@@ -0,0 +1,185 @@
+// resource_gravitee_application_api_key.go
+package gravitee
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGraviteeApplicationAPIKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeApplicationAPIKeyCreate,
+		ReadContext:   resourceGraviteeApplicationAPIKeyRead,
+		UpdateContext: resourceGraviteeApplicationAPIKeyUpdate,
+		DeleteContext: resourceGraviteeApplicationAPIKeyDelete,
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the application, which must use the SHARED API key mode",
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value, changing it renews the shared API key and revokes the previous one once the new one exists. The current key is adopted when the resource is created",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Value of the shared API key",
+			},
+			"expire_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the shared API key",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the shared API key",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceGraviteeApplicationAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	applicationID := d.Get("application_id").(string)
+
+	apiKeys, err := client.ListApplicationAPIKeys(applicationID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Adopt the key consumers already use, a key is only generated when there is none
+	if apiKey := activeAPIKey(apiKeys); apiKey != nil {
+		d.SetId(apiKey.ID)
+		return resourceGraviteeApplicationAPIKeyRead(ctx, d, m)
+	}
+
+	apiKey, err := client.RenewApplicationAPIKey(applicationID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(apiKey.ID)
+
+	return resourceGraviteeApplicationAPIKeyRead(ctx, d, m)
+}
+
+func resourceGraviteeApplicationAPIKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	applicationID := d.Get("application_id").(string)
+
+	apiKeys, err := client.ListApplicationAPIKeys(applicationID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, apiKey := range apiKeys {
+		if apiKey.ID != d.Id() {
+			continue
+		}
+
+		// A revoked or expired key has to be renewed
+		if apiKey.Revoked || apiKey.Expired {
+			break
+		}
+
+		d.Set("key", apiKey.Key)
+		d.Set("expire_at", apiKey.ExpireAt)
+		d.Set("created_at", apiKey.CreatedAt)
+		return nil
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceGraviteeApplicationAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	applicationID := d.Get("application_id").(string)
+
+	if !d.HasChange("rotation_trigger") {
+		return resourceGraviteeApplicationAPIKeyRead(ctx, d, m)
+	}
+
+	previousID := d.Id()
+
+	apiKey, err := client.RenewApplicationAPIKey(applicationID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(apiKey.ID)
+
+	// The previous key is revoked once consumers can switch to the new one
+	apiKeys, err := client.ListApplicationAPIKeys(applicationID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, previous := range apiKeys {
+		if previous.ID != previousID || previous.Revoked || previous.Expired {
+			continue
+		}
+
+		err = client.RevokeApplicationAPIKey(applicationID, previous.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGraviteeApplicationAPIKeyRead(ctx, d, m)
+}
+
+func resourceGraviteeApplicationAPIKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	applicationID := d.Get("application_id").(string)
+
+	apiKeys, err := client.ListApplicationAPIKeys(applicationID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Renewing the shared key may already have revoked or expired the previous one
+	for _, apiKey := range apiKeys {
+		if apiKey.ID != d.Id() || apiKey.Revoked || apiKey.Expired {
+			continue
+		}
+
+		err = client.RevokeApplicationAPIKey(applicationID, apiKey.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// activeAPIKey returns the most recent key that is neither revoked nor expired, or nil
+func activeAPIKey(apiKeys []gravitee.APIKey) *gravitee.APIKey {
+	var active *gravitee.APIKey
+	for i := range apiKeys {
+		if apiKeys[i].Revoked || apiKeys[i].Expired {
+			continue
+		}
+
+		if active == nil || apiKeys[i].CreatedAt > active.CreatedAt {
+			active = &apiKeys[i]
+		}
+	}
+
+	return active
+}
\ No newline at end of file
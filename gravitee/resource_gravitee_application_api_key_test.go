@@ -0,0 +1,27 @@
+// resource_gravitee_application_api_key_test.go
+package gravitee
+
+import (
+	"testing"
+)
+
+func TestActiveAPIKey(t *testing.T) {
+	apiKeys := []gravitee.APIKey{
+		{ID: "old", CreatedAt: "2024-01-01T00:00:00Z"},
+		{ID: "current", CreatedAt: "2024-06-01T00:00:00Z"},
+		{ID: "revoked", CreatedAt: "2024-07-01T00:00:00Z", Revoked: true},
+		{ID: "expired", CreatedAt: "2024-08-01T00:00:00Z", Expired: true},
+	}
+
+	if apiKey := activeAPIKey(apiKeys); apiKey == nil || apiKey.ID != "current" {
+		t.Errorf("activeAPIKey() = %v, want the current key", apiKey)
+	}
+
+	if apiKey := activeAPIKey(apiKeys[2:]); apiKey != nil {
+		t.Errorf("expected no active key among revoked and expired keys, got %v", apiKey)
+	}
+
+	if apiKey := activeAPIKey(nil); apiKey != nil {
+		t.Errorf("expected no active key without keys, got %v", apiKey)
+	}
+}
\ No newline at end of file
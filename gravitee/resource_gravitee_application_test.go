@@ -0,0 +1,160 @@
+// resource_gravitee_application_test.go
+package gravitee
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testClientCertificate returns a self-signed PEM certificate expiring at the given date
+func testClientCertificate(t *testing.T, notAfter time.Time) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestValidateClientCertificate(t *testing.T) {
+	if err := validateClientCertificate(testClientCertificate(t, time.Now().Add(24*time.Hour))); err != nil {
+		t.Errorf("unexpected error for a valid certificate: %s", err)
+	}
+
+	if err := validateClientCertificate(testClientCertificate(t, time.Now().Add(-24*time.Hour))); err == nil {
+		t.Error("expected an error for an expired certificate")
+	}
+
+	if err := validateClientCertificate("not a certificate"); err == nil {
+		t.Error("expected an error for invalid PEM data")
+	}
+}
+
+func TestClientCertificateExpiryWarning(t *testing.T) {
+	soon := testClientCertificate(t, time.Now().Add(10*24*time.Hour))
+
+	if diags := clientCertificateExpiryWarning(soon, 30); len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("expected a warning for a certificate expiring within 30 days, got %v", diags)
+	}
+
+	if diags := clientCertificateExpiryWarning(soon, 5); len(diags) != 0 {
+		t.Errorf("expected no warning for a certificate expiring after 5 days, got %v", diags)
+	}
+
+	expired := testClientCertificate(t, time.Now().Add(-24*time.Hour))
+	for _, days := range []int{0, 30} {
+		diags := clientCertificateExpiryWarning(expired, days)
+		if len(diags) != 1 || diags[0].Severity != diag.Warning || diags[0].Summary != "Application client certificate has expired" {
+			t.Errorf("expected an expired certificate warning with %d days, got %v", days, diags)
+		}
+	}
+}
+
+func TestValidateClientCertificateExpiry(t *testing.T) {
+	rawConfig := func(certificate string, days cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"settings": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"tls": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"client_certificate": cty.StringVal(certificate),
+				})}),
+			})}),
+			"client_certificate_expiry_warning_days": days,
+		})
+	}
+
+	soon := testClientCertificate(t, time.Now().Add(10*24*time.Hour))
+	cases := []struct {
+		days     cty.Value
+		warnings int
+	}{
+		{days: cty.NullVal(cty.Number), warnings: 1},
+		{days: cty.NumberIntVal(5), warnings: 0},
+		{days: cty.UnknownVal(cty.Number), warnings: 0},
+	}
+
+	for _, c := range cases {
+		resp := &schema.ValidateResourceConfigFuncResponse{}
+		validateClientCertificateExpiry(context.Background(), schema.ValidateResourceConfigFuncRequest{RawConfig: rawConfig(soon, c.days)}, resp)
+		if len(resp.Diagnostics) != c.warnings {
+			t.Errorf("expected %d warnings with %#v days, got %v", c.warnings, c.days, resp.Diagnostics)
+		}
+	}
+
+	resp := &schema.ValidateResourceConfigFuncResponse{}
+	noTLS := cty.ObjectVal(map[string]cty.Value{
+		"settings":                               cty.ListValEmpty(cty.EmptyObject),
+		"client_certificate_expiry_warning_days": cty.NullVal(cty.Number),
+	})
+	validateClientCertificateExpiry(context.Background(), schema.ValidateResourceConfigFuncRequest{RawConfig: noTLS}, resp)
+	if len(resp.Diagnostics) != 0 {
+		t.Errorf("expected no warnings without a client certificate, got %v", resp.Diagnostics)
+	}
+}
+
+func TestFlattenApplicationSimpleAppSettings(t *testing.T) {
+	application := &gravitee.Application{
+		Name: "Mobile",
+		Type: "SIMPLE",
+		Settings: &gravitee.ApplicationSettings{
+			App: &gravitee.SimpleApplicationSettings{},
+			TLS: &gravitee.ApplicationTLSSettings{ClientCertificate: "-----BEGIN CERTIFICATE-----"},
+		},
+	}
+
+	// TLS settings without app settings do not get empty app settings written back
+	d := schema.TestResourceDataRaw(t, resourceGraviteeApplication().Schema, map[string]interface{}{
+		"name": "Mobile",
+		"type": "SIMPLE",
+	})
+	if err := flattenApplication(d, application); err != nil {
+		t.Fatal(err)
+	}
+
+	if app := d.Get("settings.0.app").([]interface{}); len(app) != 0 {
+		t.Errorf("expected no app settings, got %v", app)
+	}
+
+	if got := d.Get("settings.0.tls.0.client_certificate").(string); got != "-----BEGIN CERTIFICATE-----" {
+		t.Errorf("unexpected client certificate %q", got)
+	}
+
+	// App settings set in Gravitee are read back
+	application.Settings.App = &gravitee.SimpleApplicationSettings{Type: "mobile", ClientID: "mobile-app"}
+	if err := flattenApplication(d, application); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := d.Get("settings.0.app.0.client_id").(string); got != "mobile-app" {
+		t.Errorf("expected the client ID to be read back, got %q", got)
+	}
+
+	if got := d.Get("client_id").(string); got != "mobile-app" {
+		t.Errorf("client_id = %q, want mobile-app", got)
+	}
+}
\ No newline at end of file
@@ -0,0 +1,179 @@
+// resource_gravitee_category.go
+package gravitee
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGraviteeCategory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeCategoryCreate,
+		ReadContext:   resourceGraviteeCategoryRead,
+		UpdateContext: resourceGraviteeCategoryUpdate,
+		DeleteContext: resourceGraviteeCategoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Key of the category, used in portal URLs. Generated from the name by default",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the category",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the category",
+			},
+			"picture": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Picture of the category, as a base64 data URI",
+			},
+			"background": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Background image of the category, as a base64 data URI",
+			},
+			"highlighted_api_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the API highlighted in the category",
+			},
+			"order": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Position of the category in the portal",
+			},
+			"hidden": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the category is hidden in the portal",
+			},
+			"total_apis": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of APIs in the category",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceGraviteeCategoryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	category := expandCategory(d)
+
+	createdCategory, err := client.CreateCategory(category)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdCategory.ID)
+
+	// The order and the highlighted API can only be set on update
+	if category.Order != 0 || category.HighlightAPI != "" {
+		category.ID = createdCategory.ID
+		if category.Key == "" {
+			category.Key = createdCategory.Key
+		}
+
+		err = client.UpdateCategory(category)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGraviteeCategoryRead(ctx, d, m)
+}
+
+func resourceGraviteeCategoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	category, err := client.GetCategory(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if category == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Importing by key is supported, the state always uses the ID
+	d.SetId(category.ID)
+
+	// Flatten the Category object and set to ResourceData
+	flattenCategory(d, category)
+
+	return nil
+}
+
+func resourceGraviteeCategoryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	category := expandCategory(d)
+	category.ID = d.Id()
+
+	err := client.UpdateCategory(category)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGraviteeCategoryRead(ctx, d, m)
+}
+
+func resourceGraviteeCategoryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	err := client.DeleteCategory(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper functions for expanding and flattening Category objects
+func expandCategory(d *schema.ResourceData) *gravitee.Category {
+	return &gravitee.Category{
+		Key:          d.Get("key").(string),
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		Picture:      d.Get("picture").(string),
+		Background:   d.Get("background").(string),
+		HighlightAPI: d.Get("highlighted_api_id").(string),
+		Order:        d.Get("order").(int),
+		Hidden:       d.Get("hidden").(bool),
+	}
+}
+
+func flattenCategory(d *schema.ResourceData, category *gravitee.Category) {
+	d.Set("key", category.Key)
+	d.Set("name", category.Name)
+	d.Set("description", category.Description)
+	d.Set("picture", category.Picture)
+	d.Set("background", category.Background)
+	d.Set("highlighted_api_id", category.HighlightAPI)
+	d.Set("order", category.Order)
+	d.Set("hidden", category.Hidden)
+	d.Set("total_apis", category.TotalAPIs)
+}
\ No newline at end of file
@@ -0,0 +1,387 @@
+// resource_gravitee_dictionary.go
+package gravitee
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGraviteeDictionary() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeDictionaryCreate,
+		ReadContext:   resourceGraviteeDictionaryRead,
+		UpdateContext: resourceGraviteeDictionaryUpdate,
+		DeleteContext: resourceGraviteeDictionaryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceGraviteeDictionaryCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the dictionary",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Key of the dictionary, used in EL expressions such as {#dictionaries['key']['property']}",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the dictionary",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"MANUAL", "DYNAMIC"}, false),
+				Description:  "Type of the dictionary (MANUAL, DYNAMIC)",
+			},
+			"properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Properties of a MANUAL dictionary",
+			},
+			"dynamic_properties": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Properties last fetched by the http_provider of a DYNAMIC dictionary",
+			},
+			"http_provider": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "HTTP provider fetching the properties of a DYNAMIC dictionary",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							Description:  "URL polled by the provider",
+						},
+						"method": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "GET",
+							ValidateFunc: validation.StringInSlice([]string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"}, false),
+							Description:  "HTTP method used to call the URL",
+						},
+						"headers": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: "HTTP headers sent to the URL",
+						},
+						"body": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Body sent to the URL",
+						},
+						"specification": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+							Description:      "JOLT specification transforming the response into an array of key/value objects",
+						},
+						"use_system_proxy": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to call the URL through the system proxy",
+						},
+					},
+				},
+			},
+			"trigger": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Polling schedule of a DYNAMIC dictionary",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rate": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Number of units between two polls",
+						},
+						"unit": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "SECONDS",
+							ValidateFunc: validation.StringInSlice([]string{"SECONDS", "MINUTES", "HOURS"}, false),
+							Description:  "Unit of the rate (SECONDS, MINUTES, HOURS)",
+						},
+					},
+				},
+			},
+			"started": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the http_provider of a DYNAMIC dictionary is polling. Ignored for MANUAL dictionaries",
+			},
+			"deployed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the latest version of the dictionary is deployed to the gateways",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+// resourceGraviteeDictionaryCustomizeDiff checks that the attributes match the dictionary type
+func resourceGraviteeDictionaryCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	switch d.Get("type").(string) {
+	case "MANUAL":
+		if len(d.Get("http_provider").([]interface{})) > 0 || len(d.Get("trigger").([]interface{})) > 0 {
+			return fmt.Errorf("http_provider and trigger can only be set on DYNAMIC dictionaries")
+		}
+	case "DYNAMIC":
+		if len(d.Get("properties").(map[string]interface{})) > 0 {
+			return fmt.Errorf("properties can only be set on MANUAL dictionaries, DYNAMIC dictionaries get them from their http_provider")
+		}
+		if len(d.Get("http_provider").([]interface{})) == 0 || len(d.Get("trigger").([]interface{})) == 0 {
+			return fmt.Errorf("http_provider and trigger are required on DYNAMIC dictionaries")
+		}
+	}
+
+	return nil
+}
+
+func resourceGraviteeDictionaryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	dictionary := expandDictionary(d)
+
+	createdDictionary, err := client.CreateDictionary(dictionary)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdDictionary.ID)
+
+	if dictionary.Type == "DYNAMIC" && d.Get("started").(bool) {
+		err = client.StartDictionary(createdDictionary.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("deployed").(bool) {
+		err = client.DeployDictionary(createdDictionary.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGraviteeDictionaryRead(ctx, d, m)
+}
+
+func resourceGraviteeDictionaryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	dictionary, err := client.GetDictionary(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if dictionary == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Flatten the Dictionary object and set to ResourceData
+	if err := flattenDictionary(d, dictionary); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGraviteeDictionaryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	if d.HasChangesExcept("started", "deployed") {
+		dictionary := expandDictionary(d)
+		dictionary.ID = d.Id()
+
+		err := client.UpdateDictionary(dictionary)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("type").(string) == "DYNAMIC" && d.HasChange("started") {
+		var err error
+		if d.Get("started").(bool) {
+			err = client.StartDictionary(d.Id())
+		} else {
+			err = client.StopDictionary(d.Id())
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Any change leaves the deployed version behind, redeploy it
+	if d.Get("deployed").(bool) {
+		err := client.DeployDictionary(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if d.HasChange("deployed") {
+		err := client.UndeployDictionary(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGraviteeDictionaryRead(ctx, d, m)
+}
+
+func resourceGraviteeDictionaryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	err := client.DeleteDictionary(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper functions for expanding and flattening Dictionary objects
+func expandDictionary(d *schema.ResourceData) *gravitee.Dictionary {
+	dictionary := &gravitee.Dictionary{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Type:        d.Get("type").(string),
+	}
+
+	if v, ok := d.GetOk("properties"); ok {
+		properties := make(map[string]string)
+		for key, value := range v.(map[string]interface{}) {
+			properties[key] = value.(string)
+		}
+		dictionary.Properties = properties
+	}
+
+	if v, ok := d.GetOk("http_provider"); ok {
+		providerMap := v.([]interface{})[0].(map[string]interface{})
+
+		headers := make([]gravitee.DictionaryProviderHeader, 0)
+		for name, value := range providerMap["headers"].(map[string]interface{}) {
+			headers = append(headers, gravitee.DictionaryProviderHeader{
+				Name:  name,
+				Value: value.(string),
+			})
+		}
+
+		dictionary.Provider = &gravitee.DictionaryProvider{
+			Type: "HTTP",
+			Configuration: &gravitee.DictionaryHTTPProviderConfiguration{
+				URL:            providerMap["url"].(string),
+				Method:         providerMap["method"].(string),
+				Headers:        headers,
+				Body:           providerMap["body"].(string),
+				Specification:  providerMap["specification"].(string),
+				UseSystemProxy: providerMap["use_system_proxy"].(bool),
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("trigger"); ok {
+		triggerMap := v.([]interface{})[0].(map[string]interface{})
+		dictionary.Trigger = &gravitee.DictionaryTrigger{
+			Rate: int64(triggerMap["rate"].(int)),
+			Unit: triggerMap["unit"].(string),
+		}
+	}
+
+	return dictionary
+}
+
+func flattenDictionary(d *schema.ResourceData, dictionary *gravitee.Dictionary) error {
+	d.Set("name", dictionary.Name)
+	d.Set("key", dictionary.Key)
+	d.Set("description", dictionary.Description)
+	d.Set("type", dictionary.Type)
+
+	// Properties of dynamic dictionaries are owned by the http_provider, only manual ones can drift
+	if dictionary.Type == "DYNAMIC" {
+		if err := d.Set("dynamic_properties", dictionary.Properties); err != nil {
+			return err
+		}
+		d.Set("started", dictionary.State == "STARTED")
+	} else {
+		if err := d.Set("properties", dictionary.Properties); err != nil {
+			return err
+		}
+	}
+
+	if dictionary.Provider != nil && dictionary.Provider.Configuration != nil {
+		configuration := dictionary.Provider.Configuration
+
+		headers := make(map[string]interface{})
+		for _, header := range configuration.Headers {
+			headers[header.Name] = header.Value
+		}
+
+		provider := map[string]interface{}{
+			"url":              configuration.URL,
+			"method":           configuration.Method,
+			"headers":          headers,
+			"body":             configuration.Body,
+			"specification":    configuration.Specification,
+			"use_system_proxy": configuration.UseSystemProxy,
+		}
+		if err := d.Set("http_provider", []interface{}{provider}); err != nil {
+			return err
+		}
+	} else {
+		d.Set("http_provider", nil)
+	}
+
+	if dictionary.Trigger != nil {
+		trigger := map[string]interface{}{
+			"rate": int(dictionary.Trigger.Rate),
+			"unit": dictionary.Trigger.Unit,
+		}
+		if err := d.Set("trigger", []interface{}{trigger}); err != nil {
+			return err
+		}
+	} else {
+		d.Set("trigger", nil)
+	}
+
+	// Changes made after the last deployment are not on the gateways yet
+	d.Set("deployed", dictionary.DeployedAt != 0 && dictionary.DeployedAt >= dictionary.UpdatedAt)
+
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,144 @@
+// resource_gravitee_entrypoint_mapping.go
+package gravitee
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGraviteeEntrypointMapping() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeEntrypointMappingCreate,
+		ReadContext:   resourceGraviteeEntrypointMappingRead,
+		UpdateContext: resourceGraviteeEntrypointMappingUpdate,
+		DeleteContext: resourceGraviteeEntrypointMappingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceGraviteeEntrypointMappingCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Entrypoint displayed in the portal, a URL for HTTP or a host and port for TCP and Kafka",
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "IDs of the sharding tags this entrypoint is displayed for",
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "HTTP",
+				ValidateFunc: validation.StringInSlice([]string{"HTTP", "TCP", "KAFKA"}, false),
+				Description:  "Kind of APIs the entrypoint applies to (HTTP, TCP, KAFKA)",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+// resourceGraviteeEntrypointMappingCustomizeDiff checks that the sharding tags exist
+func resourceGraviteeEntrypointMappingCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return validateShardingTags(d, m.(*gravitee.Client), "tags")
+}
+
+func resourceGraviteeEntrypointMappingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	mapping := expandEntrypointMapping(d)
+
+	createdMapping, err := client.CreateEntrypointMapping(mapping)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdMapping.ID)
+
+	return resourceGraviteeEntrypointMappingRead(ctx, d, m)
+}
+
+func resourceGraviteeEntrypointMappingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	mapping, err := client.GetEntrypointMapping(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if mapping == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Flatten the Entrypoint Mapping object and set to ResourceData
+	if err := flattenEntrypointMapping(d, mapping); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGraviteeEntrypointMappingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	mapping := expandEntrypointMapping(d)
+	mapping.ID = d.Id()
+
+	err := client.UpdateEntrypointMapping(mapping)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGraviteeEntrypointMappingRead(ctx, d, m)
+}
+
+func resourceGraviteeEntrypointMappingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	err := client.DeleteEntrypointMapping(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper functions for expanding and flattening Entrypoint Mapping objects
+func expandEntrypointMapping(d *schema.ResourceData) *gravitee.EntrypointMapping {
+	return &gravitee.EntrypointMapping{
+		Value:  d.Get("value").(string),
+		Tags:   expandStringList(d.Get("tags").(*schema.Set).List()),
+		Target: d.Get("target").(string),
+	}
+}
+
+func flattenEntrypointMapping(d *schema.ResourceData, mapping *gravitee.EntrypointMapping) error {
+	d.Set("value", mapping.Value)
+
+	if err := d.Set("tags", mapping.Tags); err != nil {
+		return err
+	}
+
+	// Older versions don't return the target, HTTP is the default
+	if mapping.Target != "" {
+		d.Set("target", mapping.Target)
+	}
+
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,227 @@
+// resource_gravitee_group.go
+package gravitee
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGraviteeGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeGroupCreate,
+		ReadContext:   resourceGraviteeGroupRead,
+		UpdateContext: resourceGraviteeGroupUpdate,
+		DeleteContext: resourceGraviteeGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the group",
+			},
+			"event_rules": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"API_CREATE", "APPLICATION_CREATE"}, false),
+				},
+				Description: "Events adding the group to new APIs or applications automatically (API_CREATE, APPLICATION_CREATE)",
+			},
+			"default_api_role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Role given on APIs to new members of the group",
+			},
+			"default_application_role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Role given on applications to new members of the group",
+			},
+			"max_invitation": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of members that can be invited in the group",
+			},
+			"lock_api_role": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the API role of members can only be changed by administrators",
+			},
+			"lock_application_role": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the application role of members can only be changed by administrators",
+			},
+			"system_invitation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether group administrators can invite existing users",
+			},
+			"email_invitation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether group administrators can invite users by email",
+			},
+			"disable_membership_notifications": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether membership notifications are disabled",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceGraviteeGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	group := expandGroup(d)
+
+	createdGroup, err := client.CreateGroup(group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdGroup.ID)
+
+	return resourceGraviteeGroupRead(ctx, d, m)
+}
+
+func resourceGraviteeGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	group, err := client.GetGroup(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if group == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Flatten the Group object and set to ResourceData
+	if err := flattenGroup(d, group); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGraviteeGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	group := expandGroup(d)
+	group.ID = d.Id()
+
+	err := client.UpdateGroup(group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGraviteeGroupRead(ctx, d, m)
+}
+
+func resourceGraviteeGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	err := client.DeleteGroup(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper functions for expanding and flattening Group objects
+func expandGroup(d *schema.ResourceData) *gravitee.Group {
+	group := &gravitee.Group{
+		Name:                           d.Get("name").(string),
+		EventRules:                     []gravitee.GroupEventRule{},
+		Roles:                          map[string]string{},
+		LockAPIRole:                    d.Get("lock_api_role").(bool),
+		LockApplicationRole:            d.Get("lock_application_role").(bool),
+		SystemInvitation:               d.Get("system_invitation").(bool),
+		EmailInvitation:                d.Get("email_invitation").(bool),
+		DisableMembershipNotifications: d.Get("disable_membership_notifications").(bool),
+	}
+
+	for _, event := range d.Get("event_rules").(*schema.Set).List() {
+		group.EventRules = append(group.EventRules, gravitee.GroupEventRule{
+			Event: event.(string),
+		})
+	}
+
+	if v, ok := d.GetOk("default_api_role"); ok {
+		group.Roles["API"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("default_application_role"); ok {
+		group.Roles["APPLICATION"] = v.(string)
+	}
+
+	// Read the raw configuration, as GetOk cannot tell a limit of 0 from no limit
+	if maxInvitationDeclared(d.GetRawConfig()) {
+		maxInvitation := d.Get("max_invitation").(int)
+		group.MaxInvitation = &maxInvitation
+	}
+
+	return group
+}
+
+func flattenGroup(d *schema.ResourceData, group *gravitee.Group) error {
+	d.Set("name", group.Name)
+	d.Set("default_api_role", group.Roles["API"])
+	d.Set("default_application_role", group.Roles["APPLICATION"])
+	d.Set("lock_api_role", group.LockAPIRole)
+	d.Set("lock_application_role", group.LockApplicationRole)
+	d.Set("system_invitation", group.SystemInvitation)
+	d.Set("email_invitation", group.EmailInvitation)
+	d.Set("disable_membership_notifications", group.DisableMembershipNotifications)
+
+	if group.MaxInvitation != nil {
+		d.Set("max_invitation", *group.MaxInvitation)
+	} else {
+		d.Set("max_invitation", nil)
+	}
+
+	eventRules := make([]string, len(group.EventRules))
+	for i, rule := range group.EventRules {
+		eventRules[i] = rule.Event
+	}
+
+	if err := d.Set("event_rules", eventRules); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// maxInvitationDeclared tells whether max_invitation is set in the configuration, including to 0
+func maxInvitationDeclared(config cty.Value) bool {
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute("max_invitation") {
+		return false
+	}
+
+	maxInvitation := config.GetAttr("max_invitation")
+	return maxInvitation.IsKnown() && !maxInvitation.IsNull()
+}
\ No newline at end of file
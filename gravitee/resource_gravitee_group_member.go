@@ -0,0 +1,145 @@
+// resource_gravitee_group_member.go
+package gravitee
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGraviteeGroupMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeGroupMemberSave,
+		ReadContext:   resourceGraviteeGroupMemberRead,
+		UpdateContext: resourceGraviteeGroupMemberSave,
+		DeleteContext: resourceGraviteeGroupMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGraviteeGroupMemberImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the group",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the user",
+			},
+			"api_role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Role of the user on the APIs of the group. Defaults to the default API role of the group",
+			},
+			"application_role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Role of the user on the applications of the group. Defaults to the default application role of the group",
+			},
+			"group_admin": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the user administrates the group",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Display name of the user",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceGraviteeGroupMemberSave(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	groupID := d.Get("group_id").(string)
+	userID := d.Get("user_id").(string)
+
+	member := &gravitee.GroupMember{
+		ID:    userID,
+		Roles: map[string]string{},
+	}
+
+	if v, ok := d.GetOk("api_role"); ok {
+		member.Roles["API"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("application_role"); ok {
+		member.Roles["APPLICATION"] = v.(string)
+	}
+
+	if d.Get("group_admin").(bool) {
+		member.Roles["GROUP"] = "ADMIN"
+	}
+
+	err := client.SaveGroupMember(groupID, member)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", groupID, userID))
+
+	return resourceGraviteeGroupMemberRead(ctx, d, m)
+}
+
+func resourceGraviteeGroupMemberRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	userID := d.Get("user_id").(string)
+
+	members, err := client.ListGroupMembers(d.Get("group_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, member := range members {
+		if member.ID == userID {
+			d.Set("api_role", member.Roles["API"])
+			d.Set("application_role", member.Roles["APPLICATION"])
+			d.Set("group_admin", member.Roles["GROUP"] == "ADMIN")
+			d.Set("display_name", member.DisplayName)
+			return nil
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceGraviteeGroupMemberDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	err := client.DeleteGroupMember(d.Get("group_id").(string), d.Get("user_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceGraviteeGroupMemberImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <group_id>/<user_id>", d.Id())
+	}
+
+	d.Set("group_id", parts[0])
+	d.Set("user_id", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
\ No newline at end of file
@@ -0,0 +1,34 @@
+// resource_gravitee_group_test.go
+package gravitee
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestMaxInvitationDeclared(t *testing.T) {
+	config := func(maxInvitation cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"name":           cty.StringVal("Developers"),
+			"max_invitation": maxInvitation,
+		})
+	}
+
+	cases := []struct {
+		config cty.Value
+		want   bool
+	}{
+		{config: config(cty.NumberIntVal(0)), want: true},
+		{config: config(cty.NumberIntVal(10)), want: true},
+		{config: config(cty.NullVal(cty.Number)), want: false},
+		{config: config(cty.UnknownVal(cty.Number)), want: false},
+		{config: cty.NullVal(cty.EmptyObject), want: false},
+	}
+
+	for _, c := range cases {
+		if got := maxInvitationDeclared(c.config); got != c.want {
+			t.Errorf("maxInvitationDeclared(%#v) = %t, want %t", c.config, got, c.want)
+		}
+	}
+}
\ No newline at end of file
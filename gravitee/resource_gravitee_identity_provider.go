@@ -0,0 +1,437 @@
+// resource_gravitee_identity_provider.go
+package gravitee
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// identityProviderConfigurationKeys maps the string attributes of the configuration block to their Gravitee keys
+var identityProviderConfigurationKeys = map[string]string{
+	"client_id":                    "clientId",
+	"client_secret":                "clientSecret",
+	"server_url":                   "serverURL",
+	"domain":                       "domain",
+	"authorize_endpoint":           "authorizeEndpoint",
+	"token_endpoint":               "tokenEndpoint",
+	"token_introspection_endpoint": "tokenIntrospectionEndpoint",
+	"userinfo_endpoint":            "userInfoEndpoint",
+	"userlogout_endpoint":          "userLogoutEndpoint",
+	"color":                        "color",
+}
+
+// identityProviderProfileKeys maps the attributes of the user_profile_mapping block to their Gravitee keys
+var identityProviderProfileKeys = map[string]string{
+	"id":         "id",
+	"first_name": "firstname",
+	"last_name":  "lastname",
+	"email":      "email",
+	"picture":    "picture",
+}
+
+func resourceGraviteeIdentityProvider() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeIdentityProviderCreate,
+		ReadContext:   resourceGraviteeIdentityProviderRead,
+		UpdateContext: resourceGraviteeIdentityProviderUpdate,
+		DeleteContext: resourceGraviteeIdentityProviderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the identity provider",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the identity provider",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"GRAVITEEIO_AM", "OIDC", "GOOGLE", "GITHUB"}, false),
+				Description:  "Type of the identity provider (GRAVITEEIO_AM, OIDC, GOOGLE, GITHUB). Azure AD is configured as OIDC",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the identity provider can be used",
+			},
+			"configuration": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Configuration of the identity provider. The attributes to set depend on its type",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"client_secret": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"server_url": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "URL of the Gravitee.io AM server (GRAVITEEIO_AM)",
+						},
+						"domain": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Security domain (GRAVITEEIO_AM)",
+						},
+						"authorize_endpoint": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Authorization endpoint (OIDC)",
+						},
+						"token_endpoint": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Token endpoint (OIDC)",
+						},
+						"token_introspection_endpoint": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Token introspection endpoint (OIDC)",
+						},
+						"userinfo_endpoint": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "UserInfo endpoint (OIDC)",
+						},
+						"userlogout_endpoint": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Logout endpoint (OIDC)",
+						},
+						"scopes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: "Scopes requested on login (OIDC, GRAVITEEIO_AM)",
+						},
+						"color": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Color of the login button",
+						},
+					},
+				},
+			},
+			"user_profile_mapping": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Mapping of the user profile claims",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"first_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"last_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"picture": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"group_mapping": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Groups given to users matching an EL condition",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"condition": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "EL condition on the user profile, e.g. {#jsonPath(#profile, '$.department') == 'IT'}",
+						},
+						"groups": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: "IDs of the groups",
+						},
+					},
+				},
+			},
+			"role_mapping": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Roles given to users matching an EL condition",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"condition": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "EL condition on the user profile",
+						},
+						"organization_roles": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: "Organization roles",
+						},
+						"environment_roles": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: "Environment roles",
+						},
+					},
+				},
+			},
+			"email_required": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether users must have an email to log in",
+			},
+			"sync_mappings": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether group and role mappings are computed again on each login",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceGraviteeIdentityProviderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	identityProvider := expandIdentityProvider(d)
+	identityProvider.Type = d.Get("type").(string)
+
+	createdIdentityProvider, err := client.CreateIdentityProvider(identityProvider)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdIdentityProvider.ID)
+
+	// Mappings are not part of the creation payload
+	identityProvider.ID = createdIdentityProvider.ID
+	err = client.UpdateIdentityProvider(identityProvider)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGraviteeIdentityProviderRead(ctx, d, m)
+}
+
+func resourceGraviteeIdentityProviderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	identityProvider, err := client.GetIdentityProvider(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if identityProvider == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Flatten the IdentityProvider object and set to ResourceData
+	if err := flattenIdentityProvider(d, identityProvider); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGraviteeIdentityProviderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	identityProvider := expandIdentityProvider(d)
+	identityProvider.ID = d.Id()
+
+	err := client.UpdateIdentityProvider(identityProvider)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGraviteeIdentityProviderRead(ctx, d, m)
+}
+
+func resourceGraviteeIdentityProviderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	err := client.DeleteIdentityProvider(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper functions for expanding and flattening IdentityProvider objects
+func expandIdentityProvider(d *schema.ResourceData) *gravitee.IdentityProvider {
+	identityProvider := &gravitee.IdentityProvider{
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		Enabled:            d.Get("enabled").(bool),
+		Configuration:      map[string]interface{}{},
+		UserProfileMapping: map[string]string{},
+		GroupMappings:      []gravitee.GroupMapping{},
+		RoleMappings:       []gravitee.RoleMapping{},
+		EmailRequired:      d.Get("email_required").(bool),
+		SyncMappings:       d.Get("sync_mappings").(bool),
+	}
+
+	configuration := d.Get("configuration").([]interface{})[0].(map[string]interface{})
+	for key, graviteeKey := range identityProviderConfigurationKeys {
+		if v := configuration[key].(string); v != "" {
+			identityProvider.Configuration[graviteeKey] = v
+		}
+	}
+
+	if scopes := configuration["scopes"].([]interface{}); len(scopes) > 0 {
+		identityProvider.Configuration["scopes"] = expandStringList(scopes)
+	}
+
+	if v, ok := d.GetOk("user_profile_mapping"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		profileMapping := v.([]interface{})[0].(map[string]interface{})
+		for key, graviteeKey := range identityProviderProfileKeys {
+			if v := profileMapping[key].(string); v != "" {
+				identityProvider.UserProfileMapping[graviteeKey] = v
+			}
+		}
+	}
+
+	for _, v := range d.Get("group_mapping").([]interface{}) {
+		groupMapping := v.(map[string]interface{})
+		identityProvider.GroupMappings = append(identityProvider.GroupMappings, gravitee.GroupMapping{
+			Condition: groupMapping["condition"].(string),
+			Groups:    expandStringList(groupMapping["groups"].([]interface{})),
+		})
+	}
+
+	for _, v := range d.Get("role_mapping").([]interface{}) {
+		roleMapping := v.(map[string]interface{})
+		identityProvider.RoleMappings = append(identityProvider.RoleMappings, gravitee.RoleMapping{
+			Condition:     roleMapping["condition"].(string),
+			Organizations: expandStringList(roleMapping["organization_roles"].([]interface{})),
+			Environments: map[string][]string{
+				"DEFAULT": expandStringList(roleMapping["environment_roles"].([]interface{})),
+			},
+		})
+	}
+
+	return identityProvider
+}
+
+func flattenIdentityProvider(d *schema.ResourceData, identityProvider *gravitee.IdentityProvider) error {
+	d.Set("name", identityProvider.Name)
+	d.Set("description", identityProvider.Description)
+	d.Set("type", identityProvider.Type)
+	d.Set("enabled", identityProvider.Enabled)
+	d.Set("email_required", identityProvider.EmailRequired)
+	d.Set("sync_mappings", identityProvider.SyncMappings)
+
+	configuration := map[string]interface{}{}
+	for key, graviteeKey := range identityProviderConfigurationKeys {
+		v, _ := identityProvider.Configuration[graviteeKey].(string)
+		configuration[key] = v
+	}
+
+	// The client secret may not be returned, keep the one from the state
+	if configuration["client_secret"] == "" {
+		configuration["client_secret"] = d.Get("configuration.0.client_secret").(string)
+	}
+
+	scopes := make([]string, 0)
+	if v, ok := identityProvider.Configuration["scopes"].([]interface{}); ok {
+		for _, scope := range v {
+			scopes = append(scopes, scope.(string))
+		}
+	}
+	configuration["scopes"] = scopes
+
+	if err := d.Set("configuration", []interface{}{configuration}); err != nil {
+		return err
+	}
+
+	profileMapping := map[string]interface{}{}
+	for key, graviteeKey := range identityProviderProfileKeys {
+		profileMapping[key] = identityProvider.UserProfileMapping[graviteeKey]
+	}
+
+	if err := d.Set("user_profile_mapping", []interface{}{profileMapping}); err != nil {
+		return err
+	}
+
+	groupMappings := make([]interface{}, len(identityProvider.GroupMappings))
+	for i, groupMapping := range identityProvider.GroupMappings {
+		groupMappings[i] = map[string]interface{}{
+			"condition": groupMapping.Condition,
+			"groups":    groupMapping.Groups,
+		}
+	}
+
+	if err := d.Set("group_mapping", groupMappings); err != nil {
+		return err
+	}
+
+	roleMappings := make([]interface{}, len(identityProvider.RoleMappings))
+	for i, roleMapping := range identityProvider.RoleMappings {
+		roleMappings[i] = map[string]interface{}{
+			"condition":          roleMapping.Condition,
+			"organization_roles": roleMapping.Organizations,
+			"environment_roles":  roleMapping.Environments["DEFAULT"],
+		}
+	}
+
+	if err := d.Set("role_mapping", roleMappings); err != nil {
+		return err
+	}
+
+	return nil
+}
\ No newline at end of file
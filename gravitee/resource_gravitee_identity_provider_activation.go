@@ -0,0 +1,134 @@
+// resource_gravitee_identity_provider_activation.go
+package gravitee
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGraviteeIdentityProviderActivation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeIdentityProviderActivationCreate,
+		ReadContext:   resourceGraviteeIdentityProviderActivationRead,
+		DeleteContext: resourceGraviteeIdentityProviderActivationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGraviteeIdentityProviderActivationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"identity_provider_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the identity provider",
+			},
+			"reference_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ORGANIZATION",
+				ValidateFunc: validation.StringInSlice([]string{"ORGANIZATION", "ENVIRONMENT"}, false),
+				Description:  "Where the identity provider is enabled: ORGANIZATION for the console, ENVIRONMENT for the portal",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceGraviteeIdentityProviderActivationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	identityProviderID := d.Get("identity_provider_id").(string)
+	referenceType := d.Get("reference_type").(string)
+
+	// The activations are replaced as a whole, keep the other identity providers enabled
+	activations, err := client.ListIdentityProviderActivations(referenceType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !hasIdentityProviderActivation(activations, identityProviderID) {
+		activations = append(activations, gravitee.IdentityProviderActivation{
+			IdentityProvider: identityProviderID,
+		})
+
+		err = client.UpdateIdentityProviderActivations(referenceType, activations)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", referenceType, identityProviderID))
+
+	return resourceGraviteeIdentityProviderActivationRead(ctx, d, m)
+}
+
+func resourceGraviteeIdentityProviderActivationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	activations, err := client.ListIdentityProviderActivations(d.Get("reference_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !hasIdentityProviderActivation(activations, d.Get("identity_provider_id").(string)) {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceGraviteeIdentityProviderActivationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	identityProviderID := d.Get("identity_provider_id").(string)
+	referenceType := d.Get("reference_type").(string)
+
+	activations, err := client.ListIdentityProviderActivations(referenceType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remaining := make([]gravitee.IdentityProviderActivation, 0, len(activations))
+	for _, activation := range activations {
+		if activation.IdentityProvider != identityProviderID {
+			remaining = append(remaining, activation)
+		}
+	}
+
+	err = client.UpdateIdentityProviderActivations(referenceType, remaining)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceGraviteeIdentityProviderActivationImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || (parts[0] != "ORGANIZATION" && parts[0] != "ENVIRONMENT") || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <ORGANIZATION|ENVIRONMENT>/<identity_provider_id>", d.Id())
+	}
+
+	d.Set("reference_type", parts[0])
+	d.Set("identity_provider_id", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func hasIdentityProviderActivation(activations []gravitee.IdentityProviderActivation, identityProviderID string) bool {
+	for _, activation := range activations {
+		if activation.IdentityProvider == identityProviderID {
+			return true
+		}
+	}
+
+	return false
+}
\ No newline at end of file
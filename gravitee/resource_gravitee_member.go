@@ -0,0 +1,172 @@
+// resource_gravitee_member.go
+package gravitee
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGraviteeAPIMember() *schema.Resource {
+	return resourceGraviteeMember("apis", "api_id", "API")
+}
+
+func resourceGraviteeApplicationMember() *schema.Resource {
+	return resourceGraviteeMember("applications", "application_id", "application")
+}
+
+// resourceGraviteeMember grants a role to a single user or group on an API or an application.
+// The resource ID is "<reference ID>/<user ID>" for a user and "<reference ID>/group/<group ID>" for a group.
+func resourceGraviteeMember(referenceType string, referenceKey string, referenceName string) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeMemberSave(referenceType, referenceKey),
+		ReadContext:   resourceGraviteeMemberRead(referenceType, referenceKey),
+		UpdateContext: resourceGraviteeMemberSave(referenceType, referenceKey),
+		DeleteContext: resourceGraviteeMemberDelete(referenceType, referenceKey),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGraviteeMemberImport(referenceKey),
+		},
+		Schema: map[string]*schema.Schema{
+			referenceKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("ID of the %s", referenceName),
+			},
+			"user_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"user_id", "group_id"},
+				Description:  "ID of the user",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the group",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringNotInSlice([]string{"PRIMARY_OWNER"}, false),
+				Description:  fmt.Sprintf("Role granted on the %s (OWNER, REVIEWER, USER or a custom role). Use primary_owner_id on the members resource to transfer ownership", referenceName),
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Display name of the user or group",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceGraviteeMemberSave(referenceType string, referenceKey string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(*gravitee.Client)
+		referenceID := d.Get(referenceKey).(string)
+		memberType, memberID := memberGrantee(d)
+
+		err := client.SaveMember(referenceType, referenceID, &gravitee.Member{
+			ID:   memberID,
+			Role: d.Get("role").(string),
+			Type: memberType,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if memberType == "GROUP" {
+			d.SetId(fmt.Sprintf("%s/group/%s", referenceID, memberID))
+		} else {
+			d.SetId(fmt.Sprintf("%s/%s", referenceID, memberID))
+		}
+
+		return resourceGraviteeMemberRead(referenceType, referenceKey)(ctx, d, m)
+	}
+}
+
+func resourceGraviteeMemberRead(referenceType string, referenceKey string) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(*gravitee.Client)
+		referenceID := d.Get(referenceKey).(string)
+		memberType, memberID := memberGrantee(d)
+
+		members, err := client.ListMembers(referenceType, referenceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, member := range members {
+			if member.ID == memberID && memberTypeOf(member) == memberType {
+				d.Set("role", member.Role)
+				d.Set("display_name", member.DisplayName)
+				return nil
+			}
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
+
+func resourceGraviteeMemberDelete(referenceType string, referenceKey string) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(*gravitee.Client)
+		memberType, memberID := memberGrantee(d)
+
+		err := client.DeleteMember(referenceType, d.Get(referenceKey).(string), memberType, memberID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
+
+func resourceGraviteeMemberImport(referenceKey string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.Split(d.Id(), "/")
+		switch {
+		case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+			d.Set(referenceKey, parts[0])
+			d.Set("user_id", parts[1])
+		case len(parts) == 3 && parts[0] != "" && parts[1] == "group" && parts[2] != "":
+			d.Set(referenceKey, parts[0])
+			d.Set("group_id", parts[2])
+		default:
+			return nil, fmt.Errorf("unexpected import ID %q, expected <%s>/<user_id> or <%s>/group/<group_id>", d.Id(), referenceKey, referenceKey)
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// memberGrantee returns the type and the ID of the user or group the role is granted to
+func memberGrantee(d *schema.ResourceData) (string, string) {
+	if v, ok := d.GetOk("group_id"); ok {
+		return "GROUP", v.(string)
+	}
+
+	return "USER", d.Get("user_id").(string)
+}
+
+// memberTypeOf returns the type of a member, members without a type being users
+func memberTypeOf(member gravitee.Member) string {
+	if member.Type == "" {
+		return "USER"
+	}
+
+	return member.Type
+}
\ No newline at end of file
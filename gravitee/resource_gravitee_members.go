@@ -0,0 +1,273 @@
+// resource_gravitee_members.go
+package gravitee
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGraviteeAPIMembers() *schema.Resource {
+	return resourceGraviteeMembers("apis", "api_id", "API")
+}
+
+func resourceGraviteeApplicationMembers() *schema.Resource {
+	return resourceGraviteeMembers("applications", "application_id", "application")
+}
+
+// resourceGraviteeMembers authoritatively manages the user and group members of an API or an
+// application: members that are not declared are removed, except the primary owner.
+// The resource ID is the reference ID.
+func resourceGraviteeMembers(referenceType string, referenceKey string, referenceName string) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeMembersSave(referenceType, referenceKey),
+		ReadContext:   resourceGraviteeMembersRead(referenceType, referenceKey),
+		UpdateContext: resourceGraviteeMembersSave(referenceType, referenceKey),
+		DeleteContext: resourceGraviteeMembersDelete(referenceType, referenceKey),
+		CustomizeDiff: resourceGraviteeMembersCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set(referenceKey, d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			referenceKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("ID of the %s", referenceName),
+			},
+			"member": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: fmt.Sprintf("Members of the %s, other than the primary owner", referenceName),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the user. Exactly one of user_id and group_id must be set",
+						},
+						"group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the group. Exactly one of user_id and group_id must be set",
+						},
+						"role": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringNotInSlice([]string{"PRIMARY_OWNER"}, false),
+							Description:  "Role granted to the user or group (OWNER, REVIEWER, USER or a custom role)",
+						},
+					},
+				},
+			},
+			"primary_owner_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: fmt.Sprintf("ID of the user owning the %s. Changing it transfers the ownership, and the previous owner keeps the role it is declared with in member, or is removed", referenceName),
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceGraviteeMembersSave(referenceType string, referenceKey string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(*gravitee.Client)
+		referenceID := d.Get(referenceKey).(string)
+
+		members, err := client.ListMembers(referenceType, referenceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		currentRoles := make(map[memberKey]string, len(members))
+		primaryOwnerID := ""
+		for _, member := range members {
+			if member.Role == "PRIMARY_OWNER" {
+				primaryOwnerID = member.ID
+				continue
+			}
+			currentRoles[memberKey{Type: memberTypeOf(member), ID: member.ID}] = member.Role
+		}
+
+		desiredRoles := expandMemberRoles(d.Get("member").(*schema.Set))
+
+		// Transfer the ownership first, so the previous owner can be managed as a regular member
+		if v, ok := d.GetOk("primary_owner_id"); ok && v.(string) != primaryOwnerID {
+			previousOwnerRole := previousOwnerRole(desiredRoles, primaryOwnerID)
+			err = client.TransferOwnership(referenceType, referenceID, v.(string), previousOwnerRole)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			delete(currentRoles, memberKey{Type: "USER", ID: v.(string)})
+			if primaryOwnerID != "" {
+				currentRoles[memberKey{Type: "USER", ID: primaryOwnerID}] = previousOwnerRole
+			}
+			primaryOwnerID = v.(string)
+		}
+
+		if _, ok := desiredRoles[memberKey{Type: "USER", ID: primaryOwnerID}]; ok {
+			return diag.Errorf("user %s is the primary owner and cannot be declared as a member", primaryOwnerID)
+		}
+
+		for key, role := range desiredRoles {
+			if currentRoles[key] == role {
+				continue
+			}
+
+			err = client.SaveMember(referenceType, referenceID, &gravitee.Member{
+				ID:   key.ID,
+				Role: role,
+				Type: key.Type,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		for key := range currentRoles {
+			if _, ok := desiredRoles[key]; ok {
+				continue
+			}
+
+			err = client.DeleteMember(referenceType, referenceID, key.Type, key.ID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		d.SetId(referenceID)
+
+		return resourceGraviteeMembersRead(referenceType, referenceKey)(ctx, d, m)
+	}
+}
+
+func resourceGraviteeMembersRead(referenceType string, referenceKey string) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(*gravitee.Client)
+
+		members, err := client.ListMembers(referenceType, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if members == nil {
+			d.SetId("")
+			return nil
+		}
+
+		result := make([]interface{}, 0, len(members))
+		for _, member := range members {
+			if member.Role == "PRIMARY_OWNER" {
+				d.Set("primary_owner_id", member.ID)
+				continue
+			}
+
+			if memberTypeOf(member) == "GROUP" {
+				result = append(result, map[string]interface{}{
+					"group_id": member.ID,
+					"role":     member.Role,
+				})
+				continue
+			}
+
+			result = append(result, map[string]interface{}{
+				"user_id": member.ID,
+				"role":    member.Role,
+			})
+		}
+
+		if err := d.Set("member", result); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+}
+
+// resourceGraviteeMembersDelete removes every declared member, the primary owner is kept
+func resourceGraviteeMembersDelete(referenceType string, referenceKey string) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(*gravitee.Client)
+		referenceID := d.Get(referenceKey).(string)
+
+		for key := range expandMemberRoles(d.Get("member").(*schema.Set)) {
+			err := client.DeleteMember(referenceType, referenceID, key.Type, key.ID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
+
+// resourceGraviteeMembersCustomizeDiff checks that every member is either a user or a group
+func resourceGraviteeMembersCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("member") {
+		return nil
+	}
+
+	for _, v := range d.Get("member").(*schema.Set).List() {
+		member := v.(map[string]interface{})
+		if err := validateMemberGrantee(member["user_id"].(string), member["group_id"].(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateMemberGrantee checks that a role is granted to exactly one user or group
+func validateMemberGrantee(userID string, groupID string) error {
+	if (userID == "") == (groupID == "") {
+		return fmt.Errorf("exactly one of user_id and group_id must be set on each member")
+	}
+
+	return nil
+}
+
+// memberKey identifies a member by its type, USER or GROUP, and its ID
+type memberKey struct {
+	Type string
+	ID   string
+}
+
+func expandMemberRoles(members *schema.Set) map[memberKey]string {
+	roles := make(map[memberKey]string, members.Len())
+	for _, v := range members.List() {
+		member := v.(map[string]interface{})
+
+		key := memberKey{Type: "USER", ID: member["user_id"].(string)}
+		if groupID := member["group_id"].(string); groupID != "" {
+			key = memberKey{Type: "GROUP", ID: groupID}
+		}
+		roles[key] = member["role"].(string)
+	}
+
+	return roles
+}
+
+// previousOwnerRole returns the role the previous primary owner keeps after a transfer. An owner
+// not declared in member is given the USER role and removed with the other undeclared members.
+func previousOwnerRole(desiredRoles map[memberKey]string, previousOwnerID string) string {
+	if role, ok := desiredRoles[memberKey{Type: "USER", ID: previousOwnerID}]; ok {
+		return role
+	}
+
+	return "USER"
+}
\ No newline at end of file
@@ -0,0 +1,65 @@
+// resource_gravitee_members_test.go
+package gravitee
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidateMemberGrantee(t *testing.T) {
+	cases := []struct {
+		userID  string
+		groupID string
+		wantErr bool
+	}{
+		{userID: "user", wantErr: false},
+		{groupID: "group", wantErr: false},
+		{userID: "user", groupID: "group", wantErr: true},
+		{wantErr: true},
+	}
+
+	for _, c := range cases {
+		err := validateMemberGrantee(c.userID, c.groupID)
+		if (err != nil) != c.wantErr {
+			t.Errorf("validateMemberGrantee(%q, %q) returned error %v, want error: %t", c.userID, c.groupID, err, c.wantErr)
+		}
+	}
+}
+
+func TestExpandMemberRoles(t *testing.T) {
+	memberSchema := resourceGraviteeAPIMembers().Schema["member"]
+	members := schema.NewSet(schema.HashResource(memberSchema.Elem.(*schema.Resource)), []interface{}{
+		map[string]interface{}{"user_id": "alice", "group_id": "", "role": "OWNER"},
+		map[string]interface{}{"user_id": "", "group_id": "developers", "role": "USER"},
+	})
+
+	want := map[memberKey]string{
+		{Type: "USER", ID: "alice"}:       "OWNER",
+		{Type: "GROUP", ID: "developers"}: "USER",
+	}
+
+	if got := expandMemberRoles(members); !reflect.DeepEqual(got, want) {
+		t.Errorf("expandMemberRoles() = %v, want %v", got, want)
+	}
+}
+
+func TestPreviousOwnerRole(t *testing.T) {
+	desiredRoles := map[memberKey]string{
+		{Type: "USER", ID: "alice"}: "OWNER",
+		{Type: "GROUP", ID: "bob"}:  "REVIEWER",
+	}
+
+	cases := map[string]string{
+		"alice": "OWNER",
+		"bob":   "USER",
+		"carol": "USER",
+	}
+
+	for previousOwnerID, want := range cases {
+		if got := previousOwnerRole(desiredRoles, previousOwnerID); got != want {
+			t.Errorf("previousOwnerRole(%q) = %q, want %q", previousOwnerID, got, want)
+		}
+	}
+}
\ No newline at end of file
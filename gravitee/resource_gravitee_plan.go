@@ -18,6 +18,7 @@ func resourceGraviteePlan() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceGraviteePlanCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"api_id": {
 				Type:        schema.TypeString,
@@ -63,6 +64,14 @@ func resourceGraviteePlan() *schema.Resource {
 				Optional:    true,
 				Description: "Validation mode for the plan",
 			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "IDs of the sharding tags restricting the gateways the plan is deployed on",
+			},
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -84,6 +93,11 @@ func resourceGraviteePlan() *schema.Resource {
 	}
 }
 
+// resourceGraviteePlanCustomizeDiff checks that the sharding tags exist
+func resourceGraviteePlanCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return validateShardingTags(d, m.(*gravitee.Client), "tags")
+}
+
 func resourceGraviteePlanCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*gravitee.Client)
 	apiID := d.Get("api_id").(string)
@@ -195,6 +209,9 @@ func expandPlan(d *schema.ResourceData) *gravitee.Plan {
 		plan.Validation = v.(string)
 	}
 
+	// Always send the tags so that removing the last one clears them
+	plan.Tags = expandStringList(d.Get("tags").(*schema.Set).List())
+
 	return plan
 }
 
@@ -216,6 +233,10 @@ func flattenPlan(d *schema.ResourceData, plan *gravitee.Plan) error {
 		d.Set("validation", plan.Validation)
 	}
 
+	if err := d.Set("tags", plan.Tags); err != nil {
+		return err
+	}
+
 	d.Set("status", plan.Status)
 
 	return nil
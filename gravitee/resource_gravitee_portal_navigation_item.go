@@ -0,0 +1,174 @@
+// resource_gravitee_portal_navigation_item.go
+package gravitee
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGraviteePortalNavigationItem() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteePortalNavigationItemCreate,
+		ReadContext:   resourceGraviteePortalNavigationItemRead,
+		UpdateContext: resourceGraviteePortalNavigationItemUpdate,
+		DeleteContext: resourceGraviteePortalNavigationItemDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceGraviteePortalNavigationItemCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Title of the item in the navigation",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"FOLDER", "PAGE", "LINK"}, false),
+				Description:  "Type of the item (FOLDER, PAGE, LINK)",
+			},
+			"area": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "TOP_NAVBAR",
+				ValidateFunc: validation.StringInSlice([]string{"TOP_NAVBAR", "HOMEPAGE"}, false),
+				Description:  "Area of the portal displaying the item (TOP_NAVBAR, HOMEPAGE)",
+			},
+			"parent_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the FOLDER item containing the item",
+			},
+			"order": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Position of the item among its siblings",
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "Target of LINK items",
+			},
+			"page_content_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the content displayed by PAGE items. An empty content is created when not set",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+// resourceGraviteePortalNavigationItemCustomizeDiff checks that the attributes match the item type
+func resourceGraviteePortalNavigationItemCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	itemType := d.Get("type").(string)
+
+	if itemType == "LINK" && d.NewValueKnown("url") && d.Get("url").(string) == "" {
+		return fmt.Errorf("url is required on LINK items")
+	}
+
+	if itemType != "LINK" && d.Get("url").(string) != "" {
+		return fmt.Errorf("url can only be set on LINK items")
+	}
+
+	return nil
+}
+
+func resourceGraviteePortalNavigationItemCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	item := expandPortalNavigationItem(d)
+
+	createdItem, err := client.CreatePortalNavigationItem(item)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdItem.ID)
+
+	return resourceGraviteePortalNavigationItemRead(ctx, d, m)
+}
+
+func resourceGraviteePortalNavigationItemRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	item, err := client.GetPortalNavigationItem(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if item == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Flatten the Portal Navigation Item object and set to ResourceData
+	flattenPortalNavigationItem(d, item)
+
+	return nil
+}
+
+func resourceGraviteePortalNavigationItemUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	item := expandPortalNavigationItem(d)
+	item.ID = d.Id()
+
+	err := client.UpdatePortalNavigationItem(item)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGraviteePortalNavigationItemRead(ctx, d, m)
+}
+
+func resourceGraviteePortalNavigationItemDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	err := client.DeletePortalNavigationItem(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper functions for expanding and flattening Portal Navigation Item objects
+func expandPortalNavigationItem(d *schema.ResourceData) *gravitee.PortalNavigationItem {
+	return &gravitee.PortalNavigationItem{
+		Title:               d.Get("title").(string),
+		Type:                d.Get("type").(string),
+		Area:                d.Get("area").(string),
+		ParentID:            d.Get("parent_id").(string),
+		Order:               d.Get("order").(int),
+		URL:                 d.Get("url").(string),
+		PortalPageContentID: d.Get("page_content_id").(string),
+	}
+}
+
+func flattenPortalNavigationItem(d *schema.ResourceData, item *gravitee.PortalNavigationItem) {
+	d.Set("title", item.Title)
+	d.Set("type", item.Type)
+	d.Set("area", item.Area)
+	d.Set("parent_id", item.ParentID)
+	d.Set("order", item.Order)
+	d.Set("url", item.URL)
+	d.Set("page_content_id", item.PortalPageContentID)
+}
\ No newline at end of file
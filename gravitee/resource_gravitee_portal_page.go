@@ -0,0 +1,168 @@
+// resource_gravitee_portal_page.go
+package gravitee
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// portalSystemFolders are the built-in folders holding the header and footer links of the portal
+var portalSystemFolders = []string{"TOP_HEADER", "TOP_FOOTER", "FOOTER"}
+
+func resourceGraviteePortalPage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteePortalPageCreate,
+		ReadContext:   resourceGraviteePortalPageRead,
+		UpdateContext: resourceGraviteePortalPageUpdate,
+		DeleteContext: resourceGraviteePortalPageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceGraviteePortalPageCustomizeDiff,
+		Schema:        pageSchema([]string{"MARKDOWN", "MARKDOWN_TEMPLATE", "SWAGGER", "ASYNCAPI", "FOLDER", "LINK", "SYSTEM_FOLDER"}),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+// resourceGraviteePortalPageCustomizeDiff checks the name of system folders, other pages are checked like API pages
+func resourceGraviteePortalPageCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("type").(string) != "SYSTEM_FOLDER" {
+		return resourceGraviteeAPIPageCustomizeDiff(ctx, d, m)
+	}
+
+	if !d.NewValueKnown("name") {
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	known := false
+	for _, systemFolder := range portalSystemFolders {
+		if name == systemFolder {
+			known = true
+		}
+	}
+	if !known {
+		return fmt.Errorf("unknown system folder %q, expected one of: %s", name, strings.Join(portalSystemFolders, ", "))
+	}
+
+	// Another system folder is another page
+	if d.Id() != "" && d.HasChange("name") {
+		return d.ForceNew("name")
+	}
+
+	return nil
+}
+
+func resourceGraviteePortalPageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	page, diags := expandPage(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	// System folders are built in, they are adopted instead of created
+	if page.Type == "SYSTEM_FOLDER" {
+		systemFolders, err := client.ListPages("", "SYSTEM_FOLDER")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, systemFolder := range systemFolders {
+			if systemFolder.Name == page.Name {
+				page.ID = systemFolder.ID
+			}
+		}
+		if page.ID == "" {
+			return diag.Errorf("no system folder named %q", page.Name)
+		}
+
+		err = client.UpdatePage("", page)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(page.ID)
+
+		return resourceGraviteePortalPageRead(ctx, d, m)
+	}
+
+	createdPage, err := client.CreatePage("", page)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdPage.ID)
+
+	return resourceGraviteePortalPageRead(ctx, d, m)
+}
+
+func resourceGraviteePortalPageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	page, err := client.GetPage("", d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if page == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Flatten the Page object and set to ResourceData
+	if err := flattenPage(d, page); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGraviteePortalPageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	page, diags := expandPage(d)
+	if diags.HasError() {
+		return diags
+	}
+	page.ID = d.Id()
+
+	err := client.UpdatePage("", page)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Refresh the content right away instead of waiting for the next scheduled fetch
+	if page.Source != nil && d.HasChange("source") {
+		err = client.FetchPage("", page.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGraviteePortalPageRead(ctx, d, m)
+}
+
+func resourceGraviteePortalPageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	// System folders cannot be deleted, they are only removed from the state
+	if d.Get("type").(string) != "SYSTEM_FOLDER" {
+		err := client.DeletePage("", d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
\ No newline at end of file
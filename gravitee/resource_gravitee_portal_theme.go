@@ -0,0 +1,432 @@
+// resource_gravitee_portal_theme.go
+package gravitee
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// themeImages are the attributes accepting images
+var themeImages = []string{"logo", "optional_logo", "favicon", "background_image"}
+
+func resourceGraviteePortalTheme() *schema.Resource {
+	validateColor := validation.StringMatch(regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`), "must be a hexadecimal color such as #1d192b")
+
+	return &schema.Resource{
+		CreateContext: resourceGraviteePortalThemeCreate,
+		ReadContext:   resourceGraviteePortalThemeRead,
+		UpdateContext: resourceGraviteePortalThemeUpdate,
+		DeleteContext: resourceGraviteePortalThemeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceGraviteePortalThemeCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PORTAL", "PORTAL_NEXT"}, false),
+				Description:  "Portal the theme applies to (PORTAL for the classic portal, PORTAL_NEXT)",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the theme",
+			},
+			"logo": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Logo, as a base64 string, a data URI or the path of an image file. The image in use is kept when not set",
+			},
+			"optional_logo": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Logo displayed on dark backgrounds, as a base64 string, a data URI or the path of an image file. The image in use is kept when not set",
+			},
+			"favicon": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Favicon, as a base64 string, a data URI or the path of an image file. The image in use is kept when not set",
+			},
+			"background_image": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Background of the classic portal homepage, as a base64 string, a data URI or the path of an image file. The image in use is kept when not set",
+			},
+			"definition_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "Definition of a PORTAL theme as JSON, holding the colors, fonts and CSS of each component",
+			},
+			"colors": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Colors of a PORTAL_NEXT theme",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateColor,
+							Description:  "Primary color",
+						},
+						"secondary": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateColor,
+							Description:  "Secondary color",
+						},
+						"tertiary": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateColor,
+							Description:  "Tertiary color",
+						},
+						"error": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateColor,
+							Description:  "Color of errors",
+						},
+						"page_background": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateColor,
+							Description:  "Background color of the pages",
+						},
+						"card_background": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateColor,
+							Description:  "Background color of the cards",
+						},
+					},
+				},
+			},
+			"font_family": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Font family of a PORTAL_NEXT theme",
+			},
+			"custom_css": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CSS added to a PORTAL_NEXT theme",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+// resourceGraviteePortalThemeCustomizeDiff checks that the attributes match the theme type
+func resourceGraviteePortalThemeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return checkThemeAttributes(d.Get("type").(string), d.GetRawConfig())
+}
+
+// checkThemeAttributes checks that the configuration only sets attributes supported by the theme type
+func checkThemeAttributes(themeType string, raw cty.Value) error {
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+
+	var unsupported []string
+	switch themeType {
+	case "PORTAL":
+		unsupported = []string{"colors", "font_family", "custom_css"}
+	case "PORTAL_NEXT":
+		unsupported = []string{"definition_json", "background_image"}
+	}
+
+	for _, attribute := range unsupported {
+		value := raw.GetAttr(attribute)
+		if value.IsNull() || (value.IsKnown() && value.CanIterateElements() && value.LengthInt() == 0) {
+			continue
+		}
+		return fmt.Errorf("%s cannot be set on %s themes", attribute, themeType)
+	}
+
+	return nil
+}
+
+func resourceGraviteePortalThemeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	themeType := d.Get("type").(string)
+
+	// Each portal has a single theme in use, it is adopted instead of created
+	currentTheme, err := client.GetCurrentTheme(themeType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if currentTheme == nil {
+		return diag.Errorf("no %s theme is in use", themeType)
+	}
+
+	theme, err := expandTheme(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	theme.ID = currentTheme.ID
+	if theme.Name == "" {
+		theme.Name = currentTheme.Name
+	}
+
+	// Images that are not set keep the ones in use
+	if theme.Logo == "" {
+		theme.Logo = currentTheme.Logo
+	}
+	if theme.OptionalLogo == "" {
+		theme.OptionalLogo = currentTheme.OptionalLogo
+	}
+	if theme.Favicon == "" {
+		theme.Favicon = currentTheme.Favicon
+	}
+	if theme.BackgroundImage == "" {
+		theme.BackgroundImage = currentTheme.BackgroundImage
+	}
+
+	err = client.UpdateTheme(theme)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(theme.ID)
+
+	return resourceGraviteePortalThemeRead(ctx, d, m)
+}
+
+func resourceGraviteePortalThemeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	theme, err := client.GetTheme(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if theme == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Flatten the Theme object and set to ResourceData
+	if err := flattenTheme(d, theme); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGraviteePortalThemeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	theme, err := expandTheme(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	theme.ID = d.Id()
+
+	err = client.UpdateTheme(theme)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGraviteePortalThemeRead(ctx, d, m)
+}
+
+func resourceGraviteePortalThemeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	// The theme stays in use, it is reset to the default one of Gravitee
+	defaultTheme, err := client.GetDefaultTheme(d.Get("type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if defaultTheme != nil {
+		defaultTheme.ID = d.Id()
+		defaultTheme.Name = d.Get("name").(string)
+		defaultTheme.Enabled = true
+
+		err = client.UpdateTheme(defaultTheme)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// expandThemeImage turns a file path or a base64 string into the data URI expected by Gravitee
+func expandThemeImage(value string) (string, error) {
+	if value == "" || strings.HasPrefix(value, "data:") {
+		return value, nil
+	}
+
+	if info, err := os.Stat(value); err == nil && !info.IsDir() {
+		content, err := os.ReadFile(value)
+		if err != nil {
+			return "", err
+		}
+
+		mediaType := mime.TypeByExtension(filepath.Ext(value))
+		if mediaType == "" {
+			mediaType = http.DetectContentType(content)
+		}
+
+		return fmt.Sprintf("data:%s;base64,%s", mediaType, base64.StdEncoding.EncodeToString(content)), nil
+	}
+
+	content, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", fmt.Errorf("image is neither a data URI, a readable file nor a base64 string: %w", err)
+	}
+
+	return fmt.Sprintf("data:%s;base64,%s", http.DetectContentType(content), value), nil
+}
+
+// Helper functions for expanding and flattening Theme objects
+func expandTheme(d *schema.ResourceData) (*gravitee.Theme, error) {
+	theme := &gravitee.Theme{
+		Name:    d.Get("name").(string),
+		Type:    d.Get("type").(string),
+		Enabled: true,
+	}
+
+	images := make(map[string]string, len(themeImages))
+	for _, attribute := range themeImages {
+		image, err := expandThemeImage(d.Get(attribute).(string))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", attribute, err)
+		}
+		images[attribute] = image
+	}
+	theme.Logo = images["logo"]
+	theme.OptionalLogo = images["optional_logo"]
+	theme.Favicon = images["favicon"]
+	theme.BackgroundImage = images["background_image"]
+
+	if theme.Type == "PORTAL" {
+		if v, ok := d.GetOk("definition_json"); ok {
+			theme.Definition = json.RawMessage(v.(string))
+		}
+		return theme, nil
+	}
+
+	definition := &gravitee.PortalNextThemeDefinition{
+		CustomCSS: d.Get("custom_css").(string),
+	}
+
+	if v, ok := d.GetOk("colors"); ok && v.([]interface{})[0] != nil {
+		colorsMap := v.([]interface{})[0].(map[string]interface{})
+		definition.Color = &gravitee.PortalNextThemeColor{
+			Primary:        colorsMap["primary"].(string),
+			Secondary:      colorsMap["secondary"].(string),
+			Tertiary:       colorsMap["tertiary"].(string),
+			Error:          colorsMap["error"].(string),
+			PageBackground: colorsMap["page_background"].(string),
+			CardBackground: colorsMap["card_background"].(string),
+		}
+	}
+
+	if v, ok := d.GetOk("font_family"); ok {
+		definition.Font = &gravitee.PortalNextThemeFont{
+			FontFamily: v.(string),
+		}
+	}
+
+	definitionJSON, err := json.Marshal(definition)
+	if err != nil {
+		return nil, err
+	}
+	theme.Definition = definitionJSON
+
+	return theme, nil
+}
+
+func flattenTheme(d *schema.ResourceData, theme *gravitee.Theme) error {
+	d.Set("name", theme.Name)
+	d.Set("type", theme.Type)
+
+	remoteImages := map[string]string{
+		"logo":             theme.Logo,
+		"optional_logo":    theme.OptionalLogo,
+		"favicon":          theme.Favicon,
+		"background_image": theme.BackgroundImage,
+	}
+
+	// Images are kept as configured, files and base64 strings only drift when their data URI does
+	for _, attribute := range themeImages {
+		configured, err := expandThemeImage(d.Get(attribute).(string))
+		if err != nil || configured != remoteImages[attribute] {
+			d.Set(attribute, remoteImages[attribute])
+		}
+	}
+
+	if theme.Type == "PORTAL" {
+		if len(theme.Definition) > 0 {
+			d.Set("definition_json", string(theme.Definition))
+		}
+		return nil
+	}
+
+	var definition gravitee.PortalNextThemeDefinition
+	if len(theme.Definition) > 0 {
+		if err := json.Unmarshal(theme.Definition, &definition); err != nil {
+			return err
+		}
+	}
+
+	if definition.Color != nil {
+		colors := map[string]interface{}{
+			"primary":         definition.Color.Primary,
+			"secondary":       definition.Color.Secondary,
+			"tertiary":        definition.Color.Tertiary,
+			"error":           definition.Color.Error,
+			"page_background": definition.Color.PageBackground,
+			"card_background": definition.Color.CardBackground,
+		}
+		if err := d.Set("colors", []interface{}{colors}); err != nil {
+			return err
+		}
+	}
+
+	if definition.Font != nil {
+		d.Set("font_family", definition.Font.FontFamily)
+	}
+
+	d.Set("custom_css", definition.CustomCSS)
+
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,87 @@
+// resource_gravitee_portal_theme_test.go
+package gravitee
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestExpandThemeImage(t *testing.T) {
+	// A 1x1 transparent PNG
+	png := "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAQAAAC1HAwCAAAAC0lEQVR42mNkYAAAAAYAAjCB0C8AAAAASUVORK5CYII="
+	dataURI := "data:image/png;base64," + png
+
+	path := filepath.Join(t.TempDir(), "logo.svg")
+	if err := os.WriteFile(path, []byte(`<svg xmlns="http://www.w3.org/2000/svg"/>`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "", want: ""},
+		{value: dataURI, want: dataURI},
+		{value: png, want: dataURI},
+		{value: path, want: "data:image/svg+xml;base64,PHN2ZyB4bWxucz0iaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmciLz4="},
+		{value: "not an image!", wantErr: true},
+		{value: filepath.Join(t.TempDir(), "missing.png"), wantErr: true},
+	}
+
+	for _, c := range cases {
+		got, err := expandThemeImage(c.value)
+		if (err != nil) != c.wantErr {
+			t.Errorf("expandThemeImage(%q) returned error %v, want error: %t", c.value, err, c.wantErr)
+			continue
+		}
+
+		if got != c.want {
+			t.Errorf("expandThemeImage(%q) = %q, want %q", c.value, got, c.want)
+		}
+	}
+}
+
+func TestCheckThemeAttributes(t *testing.T) {
+	config := func(attributes map[string]cty.Value) cty.Value {
+		values := map[string]cty.Value{
+			"definition_json":  cty.NullVal(cty.String),
+			"background_image": cty.NullVal(cty.String),
+			"colors":           cty.ListValEmpty(cty.Map(cty.String)),
+			"font_family":      cty.NullVal(cty.String),
+			"custom_css":       cty.NullVal(cty.String),
+		}
+		for name, value := range attributes {
+			values[name] = value
+		}
+		return cty.ObjectVal(values)
+	}
+	colors := cty.ListVal([]cty.Value{cty.MapVal(map[string]cty.Value{"primary": cty.StringVal("#1d192b")})})
+
+	cases := []struct {
+		themeType  string
+		attributes map[string]cty.Value
+		wantErr    bool
+	}{
+		{themeType: "PORTAL", attributes: map[string]cty.Value{"definition_json": cty.StringVal("{}"), "background_image": cty.StringVal("data:")}},
+		{themeType: "PORTAL", attributes: map[string]cty.Value{"colors": colors}, wantErr: true},
+		{themeType: "PORTAL", attributes: map[string]cty.Value{"custom_css": cty.StringVal("body {}")}, wantErr: true},
+		{themeType: "PORTAL_NEXT", attributes: map[string]cty.Value{"colors": colors, "font_family": cty.StringVal("Roboto")}},
+		{themeType: "PORTAL_NEXT", attributes: map[string]cty.Value{"definition_json": cty.StringVal("{}")}, wantErr: true},
+		{themeType: "PORTAL_NEXT", attributes: map[string]cty.Value{"background_image": cty.UnknownVal(cty.String)}, wantErr: true},
+	}
+
+	for _, c := range cases {
+		err := checkThemeAttributes(c.themeType, config(c.attributes))
+		if (err != nil) != c.wantErr {
+			t.Errorf("checkThemeAttributes(%q, %v) returned error %v, want error: %t", c.themeType, c.attributes, err, c.wantErr)
+		}
+	}
+
+	if err := checkThemeAttributes("PORTAL", cty.DynamicVal); err != nil {
+		t.Errorf("expected an unknown configuration to be skipped, got %v", err)
+	}
+}
\ No newline at end of file
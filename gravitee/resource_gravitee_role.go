@@ -0,0 +1,232 @@
+// resource_gravitee_role.go
+package gravitee
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// crudLetters is the canonical order of permission letters
+const crudLetters = "CRUD"
+
+func resourceGraviteeRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeRoleCreate,
+		ReadContext:   resourceGraviteeRoleRead,
+		UpdateContext: resourceGraviteeRoleUpdate,
+		DeleteContext: resourceGraviteeRoleDelete,
+		CustomizeDiff: resourceGraviteeRoleCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGraviteeRoleImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ORGANIZATION", "ENVIRONMENT", "API", "APPLICATION", "GROUP", "INTEGRATION"}, false),
+				Description:  "Scope of the role (ORGANIZATION, ENVIRONMENT, API, APPLICATION, GROUP, INTEGRATION)",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role, unique within its scope",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the role",
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the role is given by default to new members of the scope",
+			},
+			"permissions": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ValidateDiagFunc: validation.AllDiag(
+					validation.MapValueLenBetween(1, 4),
+					validation.MapValueMatch(regexp.MustCompile(`^C?R?U?D?$`), "permission values must be a combination of the letters C, R, U and D, in this order"),
+				),
+				Description: "Permissions of the role, mapping a permission name to CRUD letters (e.g. DEFINITION = \"RU\")",
+			},
+			"system": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the role is a system role",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+// resourceGraviteeRoleCustomizeDiff validates the permissions against the permission catalog of the scope
+func resourceGraviteeRoleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("permissions") || !d.NewValueKnown("permissions") || !d.NewValueKnown("scope") {
+		return nil
+	}
+
+	client := m.(*gravitee.Client)
+	scope := d.Get("scope").(string)
+
+	roleScopes, err := client.GetRoleScopes()
+	if err != nil {
+		return err
+	}
+
+	catalog, ok := roleScopes[scope]
+	if !ok {
+		return nil
+	}
+
+	known := make(map[string]bool, len(catalog))
+	for _, permission := range catalog {
+		known[permission] = true
+	}
+
+	for permission := range d.Get("permissions").(map[string]interface{}) {
+		if !known[permission] {
+			return fmt.Errorf("unknown %s permission %q, expected one of: %s", scope, permission, strings.Join(catalog, ", "))
+		}
+	}
+
+	return nil
+}
+
+func resourceGraviteeRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	role := expandRole(d)
+
+	createdRole, err := client.CreateRole(role)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", createdRole.Scope, createdRole.Name))
+
+	return resourceGraviteeRoleRead(ctx, d, m)
+}
+
+func resourceGraviteeRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	role, err := client.GetRole(d.Get("scope").(string), d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if role == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Flatten the Role object and set to ResourceData
+	if err := flattenRole(d, role); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGraviteeRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	role := expandRole(d)
+
+	err := client.UpdateRole(role)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGraviteeRoleRead(ctx, d, m)
+}
+
+func resourceGraviteeRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	err := client.DeleteRole(d.Get("scope").(string), d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceGraviteeRoleImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <scope>/<name>", d.Id())
+	}
+
+	d.Set("scope", parts[0])
+	d.Set("name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// Helper functions for expanding and flattening Role objects
+func expandRole(d *schema.ResourceData) *gravitee.Role {
+	role := &gravitee.Role{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Scope:       d.Get("scope").(string),
+		Default:     d.Get("default").(bool),
+		Permissions: map[string][]string{},
+	}
+
+	for permission, letters := range d.Get("permissions").(map[string]interface{}) {
+		role.Permissions[permission] = strings.Split(letters.(string), "")
+	}
+
+	return role
+}
+
+func flattenRole(d *schema.ResourceData, role *gravitee.Role) error {
+	d.Set("name", role.Name)
+	d.Set("description", role.Description)
+	d.Set("scope", role.Scope)
+	d.Set("default", role.Default)
+	d.Set("system", role.System)
+
+	permissions := make(map[string]string, len(role.Permissions))
+	for permission, letters := range role.Permissions {
+		// Permissions without any letter are not granted
+		if len(letters) == 0 {
+			continue
+		}
+
+		joined := strings.Join(letters, "")
+		granted := ""
+		for _, letter := range crudLetters {
+			if strings.ContainsRune(joined, letter) {
+				granted += string(letter)
+			}
+		}
+		permissions[permission] = granted
+	}
+
+	if err := d.Set("permissions", permissions); err != nil {
+		return err
+	}
+
+	return nil
+}
\ No newline at end of file
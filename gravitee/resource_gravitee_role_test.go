@@ -0,0 +1,31 @@
+// resource_gravitee_role_test.go
+package gravitee
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestRolePermissionsValidation(t *testing.T) {
+	validate := resourceGraviteeRole().Schema["permissions"].ValidateDiagFunc
+
+	cases := []struct {
+		permission string
+		wantErr    bool
+	}{
+		{permission: "CRUD"},
+		{permission: "RU"},
+		{permission: "D"},
+		{permission: "", wantErr: true},
+		{permission: "UR", wantErr: true},
+		{permission: "CRUDX", wantErr: true},
+	}
+
+	for _, c := range cases {
+		diags := validate(map[string]interface{}{"DEFINITION": c.permission}, cty.GetAttrPath("permissions"))
+		if diags.HasError() != c.wantErr {
+			t.Errorf("permission %q returned %v, want error: %t", c.permission, diags, c.wantErr)
+		}
+	}
+}
\ No newline at end of file
@@ -0,0 +1,428 @@
+// resource_gravitee_settings.go
+package gravitee
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// settingsField maps an attribute to a path of the settings document.
+// Attributes with flags are sets of values, each enabling the boolean at its path.
+type settingsField struct {
+	attribute string
+	path      string
+	flags     map[string]string
+	schema    *schema.Schema
+}
+
+func resourceGraviteeEnvironmentSettings() *schema.Resource {
+	return resourceGraviteeSettings("ENVIRONMENT", settingsEnvironmentFields())
+}
+
+// settingsEnvironmentFields are the settings of environments
+func settingsEnvironmentFields() []settingsField {
+	return append(settingsCommonFields(), []settingsField{
+		{
+			attribute: "api_key_header",
+			path:      "portal.apikeyHeader",
+			schema:    settingsSchema(schema.TypeString, "HTTP header carrying the API key"),
+		},
+		{
+			attribute: "portal_url",
+			path:      "portal.url",
+			schema:    settingsSchema(schema.TypeString, "URL of the developer portal"),
+		},
+		{
+			attribute: "user_registration_enabled",
+			path:      "portal.userCreation.enabled",
+			schema:    settingsSchema(schema.TypeBool, "Whether users can register on the portal"),
+		},
+		{
+			attribute: "user_registration_automatic_validation",
+			path:      "portal.userCreation.automaticValidation.enabled",
+			schema:    settingsSchema(schema.TypeBool, "Whether registered users are validated without an administrator"),
+		},
+		{
+			attribute: "api_review_enabled",
+			path:      "apiReview.enabled",
+			schema:    settingsSchema(schema.TypeBool, "Whether APIs must be reviewed before being published"),
+		},
+		{
+			attribute: "api_score_enabled",
+			path:      "apiScore.enabled",
+			schema:    settingsSchema(schema.TypeBool, "Whether APIs are scored against the rulesets of the environment"),
+		},
+		{
+			attribute: "analytics_client_timeout",
+			path:      "analytics.clientTimeout",
+			schema:    settingsSchema(schema.TypeInt, "Timeout of the analytics requests, in milliseconds"),
+		},
+		{
+			attribute: "plan_security_types",
+			flags: map[string]string{
+				"API_KEY":  "plan.security.apikey.enabled",
+				"JWT":      "plan.security.jwt.enabled",
+				"KEY_LESS": "plan.security.keyless.enabled",
+				"MTLS":     "plan.security.mtls.enabled",
+				"OAUTH2":   "plan.security.oauth2.enabled",
+				"PUSH":     "plan.security.push.enabled",
+			},
+			schema: &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"API_KEY", "JWT", "KEY_LESS", "MTLS", "OAUTH2", "PUSH"}, false),
+				},
+				Description: "Plan security types allowed in the environment (API_KEY, JWT, KEY_LESS, MTLS, OAUTH2, PUSH)",
+			},
+		},
+		{
+			attribute: "custom_api_key_enabled",
+			path:      "plan.security.customApiKey.enabled",
+			schema:    settingsSchema(schema.TypeBool, "Whether subscribers can provide their own API keys"),
+		},
+		{
+			attribute: "shared_api_key_enabled",
+			path:      "plan.security.sharedApiKey.enabled",
+			schema:    settingsSchema(schema.TypeBool, "Whether an application can share one API key across its subscriptions"),
+		},
+	}...)
+}
+
+func resourceGraviteeOrganizationSettings() *schema.Resource {
+	return resourceGraviteeSettings("ORGANIZATION", append(settingsCommonFields(), []settingsField{
+		{
+			attribute: "management_title",
+			path:      "management.title",
+			schema:    settingsSchema(schema.TypeString, "Title of the management console"),
+		},
+		{
+			attribute: "management_url",
+			path:      "management.url",
+			schema:    settingsSchema(schema.TypeString, "URL of the management console"),
+		},
+		{
+			attribute: "support_enabled",
+			path:      "management.support.enabled",
+			schema:    settingsSchema(schema.TypeBool, "Whether the support tickets are enabled"),
+		},
+		{
+			attribute: "user_creation_enabled",
+			path:      "management.userCreation.enabled",
+			schema:    settingsSchema(schema.TypeBool, "Whether users can register on the management console"),
+		},
+		{
+			attribute: "user_creation_automatic_validation",
+			path:      "management.automaticValidation.enabled",
+			schema:    settingsSchema(schema.TypeBool, "Whether registered users are validated without an administrator"),
+		},
+		{
+			attribute: "local_login_enabled",
+			path:      "authentication.localLogin.enabled",
+			schema:    settingsSchema(schema.TypeBool, "Whether users can log in with a Gravitee password, besides identity providers"),
+		},
+		{
+			attribute: "alert_enabled",
+			path:      "alert.enabled",
+			schema:    settingsSchema(schema.TypeBool, "Whether alerting is enabled"),
+		},
+	}...))
+}
+
+// settingsCommonFields are the settings shared by organizations and environments
+func settingsCommonFields() []settingsField {
+	stringList := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: description,
+		}
+	}
+
+	return []settingsField{
+		{
+			attribute: "cors_allow_origin",
+			path:      "cors.allowOrigin",
+			schema:    stringList("Origins allowed by CORS, * for all"),
+		},
+		{
+			attribute: "cors_allow_headers",
+			path:      "cors.allowHeaders",
+			schema:    stringList("Headers allowed by CORS"),
+		},
+		{
+			attribute: "cors_allow_methods",
+			path:      "cors.allowMethods",
+			schema:    stringList("Methods allowed by CORS"),
+		},
+		{
+			attribute: "cors_exposed_headers",
+			path:      "cors.exposedHeaders",
+			schema:    stringList("Headers exposed by CORS"),
+		},
+		{
+			attribute: "cors_max_age",
+			path:      "cors.maxAge",
+			schema:    settingsSchema(schema.TypeInt, "Duration CORS preflight responses are cached, in seconds"),
+		},
+		{
+			attribute: "recaptcha_enabled",
+			path:      "reCaptcha.enabled",
+			schema:    settingsSchema(schema.TypeBool, "Whether reCAPTCHA protects the registration and login forms"),
+		},
+		{
+			attribute: "recaptcha_site_key",
+			path:      "reCaptcha.siteKey",
+			schema:    settingsSchema(schema.TypeString, "reCAPTCHA site key"),
+		},
+	}
+}
+
+func settingsSchema(valueType schema.ValueType, description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        valueType,
+		Optional:    true,
+		Description: description,
+	}
+}
+
+// resourceGraviteeSettings manages the settings of an ORGANIZATION or an ENVIRONMENT.
+// Only the declared attributes are written and refreshed, the rest of the document is left alone.
+// An import reads every attribute, the ones left out of the configuration are dropped on the next apply.
+// The resource ID is DEFAULT, the only organization and environment the provider works with.
+func resourceGraviteeSettings(referenceType string, fields []settingsField) *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"additional_settings": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			Description: "Other settings, keyed by their dotted path in the settings document (such as portal.support.enabled), with JSON values",
+		},
+	}
+	for _, field := range fields {
+		resourceSchema[field.attribute] = field.schema
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceGraviteeSettingsSave(referenceType, fields),
+		ReadContext:   resourceGraviteeSettingsRead(referenceType, fields),
+		UpdateContext: resourceGraviteeSettingsSave(referenceType, fields),
+		DeleteContext: resourceGraviteeSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGraviteeSettingsImport(referenceType, fields),
+		},
+		Schema: resourceSchema,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceGraviteeSettingsSave(referenceType string, fields []settingsField) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(*gravitee.Client)
+
+		settings, err := client.GetSettings(referenceType)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		config := d.GetRawConfig()
+		expandSettings(d, settings, fields, func(attribute string) bool {
+			return !config.GetAttr(attribute).IsNull()
+		})
+
+		for path, rawValue := range d.Get("additional_settings").(map[string]interface{}) {
+			var value interface{}
+			if err := json.Unmarshal([]byte(rawValue.(string)), &value); err != nil {
+				return diag.Errorf("additional_settings[%q]: %s", path, err)
+			}
+			setSettingsPath(settings, path, value)
+		}
+
+		err = client.UpdateSettings(referenceType, settings)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId("DEFAULT")
+
+		return resourceGraviteeSettingsRead(referenceType, fields)(ctx, d, m)
+	}
+}
+
+func resourceGraviteeSettingsRead(referenceType string, fields []settingsField) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(*gravitee.Client)
+
+		settings, err := client.GetSettings(referenceType)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = flattenSettings(d, settings, fields, func(attribute string) bool {
+			return declaredSetting(d, attribute)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		additionalSettings := make(map[string]interface{})
+		for path, rawValue := range d.Get("additional_settings").(map[string]interface{}) {
+			value, err := json.Marshal(getSettingsPath(settings, path))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			// Keep the configured formatting when the values are equivalent
+			if normalized, err := structure.NormalizeJsonString(rawValue); err == nil && normalized == string(value) {
+				additionalSettings[path] = rawValue
+			} else {
+				additionalSettings[path] = string(value)
+			}
+		}
+		if err := d.Set("additional_settings", additionalSettings); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+}
+
+// resourceGraviteeSettingsImport reads every attribute, as nothing is declared yet after an import
+func resourceGraviteeSettingsImport(referenceType string, fields []settingsField) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		client := m.(*gravitee.Client)
+
+		settings, err := client.GetSettings(referenceType)
+		if err != nil {
+			return nil, err
+		}
+
+		err = flattenSettings(d, settings, fields, func(attribute string) bool {
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func resourceGraviteeSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Settings cannot be deleted, they are only removed from the state
+	d.SetId("")
+	return nil
+}
+
+// declaredSetting tells whether an attribute is managed, from the plan when applying or from the state when refreshing
+func declaredSetting(d *schema.ResourceData, attribute string) bool {
+	for _, raw := range []cty.Value{d.GetRawPlan(), d.GetRawState()} {
+		if !raw.IsNull() && raw.IsKnown() {
+			return !raw.GetAttr(attribute).IsNull()
+		}
+	}
+
+	return false
+}
+
+// Helper functions for expanding and flattening Settings documents
+func expandSettings(d *schema.ResourceData, settings map[string]interface{}, fields []settingsField, declared func(attribute string) bool) {
+	for _, field := range fields {
+		if !declared(field.attribute) {
+			continue
+		}
+
+		if field.flags != nil {
+			enabled := d.Get(field.attribute).(*schema.Set)
+			for value, path := range field.flags {
+				setSettingsPath(settings, path, enabled.Contains(value))
+			}
+			continue
+		}
+
+		setSettingsPath(settings, field.path, d.Get(field.attribute))
+	}
+}
+
+func flattenSettings(d *schema.ResourceData, settings map[string]interface{}, fields []settingsField, declared func(attribute string) bool) error {
+	for _, field := range fields {
+		if !declared(field.attribute) {
+			continue
+		}
+
+		if field.flags != nil {
+			enabled := make([]interface{}, 0, len(field.flags))
+			for value, path := range field.flags {
+				if flag, _ := getSettingsPath(settings, path).(bool); flag {
+					enabled = append(enabled, value)
+				}
+			}
+			if err := d.Set(field.attribute, enabled); err != nil {
+				return err
+			}
+			continue
+		}
+
+		value := getSettingsPath(settings, field.path)
+		// JSON numbers are decoded as float64
+		if number, ok := value.(float64); ok && field.schema.Type == schema.TypeInt {
+			value = int(number)
+		}
+		if value == nil {
+			continue
+		}
+		if err := d.Set(field.attribute, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getSettingsPath(settings map[string]interface{}, path string) interface{} {
+	var value interface{} = settings
+	for _, key := range strings.Split(path, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+
+	return value
+}
+
+func setSettingsPath(settings map[string]interface{}, path string, value interface{}) {
+	keys := strings.Split(path, ".")
+
+	object := settings
+	for _, key := range keys[:len(keys)-1] {
+		child, ok := object[key].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			object[key] = child
+		}
+		object = child
+	}
+
+	object[keys[len(keys)-1]] = value
+}
\ No newline at end of file
@@ -0,0 +1,104 @@
+// resource_gravitee_settings_test.go
+package gravitee
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSettingsPath(t *testing.T) {
+	settings := map[string]interface{}{
+		"portal": map[string]interface{}{
+			"url": "https://portal.example.com",
+		},
+		"analytics": "not an object",
+	}
+
+	setSettingsPath(settings, "portal.userCreation.enabled", true)
+	setSettingsPath(settings, "cors.maxAge", 3600)
+
+	cases := map[string]interface{}{
+		"portal.url":                  "https://portal.example.com",
+		"portal.userCreation.enabled": true,
+		"cors.maxAge":                 3600,
+		"portal.missing":              nil,
+		"analytics.clientTimeout":     nil,
+	}
+
+	for path, want := range cases {
+		if got := getSettingsPath(settings, path); !reflect.DeepEqual(got, want) {
+			t.Errorf("getSettingsPath(%q) = %#v, want %#v", path, got, want)
+		}
+	}
+
+	// Values that are not objects are replaced by the objects leading to the path
+	setSettingsPath(settings, "analytics.clientTimeout", 30000)
+	if got := getSettingsPath(settings, "analytics.clientTimeout"); got != 30000 {
+		t.Errorf("expected the analytics timeout to be set, got %#v", got)
+	}
+}
+
+func TestSettingsRoundTrip(t *testing.T) {
+	resource := resourceGraviteeEnvironmentSettings()
+	fields := settingsEnvironmentFields()
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"cors_allow_origin":        []interface{}{"https://portal.example.com", "https://console.example.com"},
+		"cors_allow_methods":       []interface{}{"GET", "POST"},
+		"cors_max_age":             3600,
+		"plan_security_types":      []interface{}{"API_KEY", "JWT"},
+		"analytics_client_timeout": 30000,
+	})
+	declared := func(attribute string) bool {
+		_, ok := d.GetOk(attribute)
+		return ok
+	}
+
+	settings := map[string]interface{}{"cors": map[string]interface{}{"allowHeaders": []interface{}{"Authorization"}}}
+	expandSettings(d, settings, fields, declared)
+
+	want := map[string]interface{}{
+		"cors.allowOrigin":              []interface{}{"https://portal.example.com", "https://console.example.com"},
+		"cors.allowMethods":             []interface{}{"GET", "POST"},
+		"cors.allowHeaders":             []interface{}{"Authorization"},
+		"cors.maxAge":                   3600,
+		"plan.security.apikey.enabled":  true,
+		"plan.security.jwt.enabled":     true,
+		"plan.security.keyless.enabled": false,
+		"plan.security.mtls.enabled":    false,
+		"analytics.clientTimeout":       30000,
+	}
+	for path, value := range want {
+		if got := getSettingsPath(settings, path); !reflect.DeepEqual(got, value) {
+			t.Errorf("settings %q = %#v, want %#v", path, got, value)
+		}
+	}
+
+	// Settings read back as JSON numbers give the configured values
+	setSettingsPath(settings, "analytics.clientTimeout", float64(30000))
+	setSettingsPath(settings, "cors.maxAge", float64(3600))
+
+	flattened := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+	if err := flattenSettings(flattened, settings, fields, func(attribute string) bool { return true }); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, attribute := range []string{"cors_allow_origin", "cors_allow_methods", "cors_max_age", "analytics_client_timeout"} {
+		if got, want := flattened.Get(attribute), d.Get(attribute); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s = %#v, want %#v", attribute, got, want)
+		}
+	}
+
+	if got := flattened.Get("cors_allow_headers"); !reflect.DeepEqual(got, []interface{}{"Authorization"}) {
+		t.Errorf("expected the CORS headers to be read on import, got %#v", got)
+	}
+
+	securityTypes := expandStringList(flattened.Get("plan_security_types").(*schema.Set).List())
+	sort.Strings(securityTypes)
+	if !reflect.DeepEqual(securityTypes, []string{"API_KEY", "JWT"}) {
+		t.Errorf("plan_security_types = %v, want [API_KEY JWT]", securityTypes)
+	}
+}
\ No newline at end of file
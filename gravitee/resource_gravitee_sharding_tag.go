@@ -0,0 +1,171 @@
+// resource_gravitee_sharding_tag.go
+package gravitee
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGraviteeShardingTag() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeShardingTagCreate,
+		ReadContext:   resourceGraviteeShardingTagRead,
+		UpdateContext: resourceGraviteeShardingTagUpdate,
+		DeleteContext: resourceGraviteeShardingTagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the sharding tag",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the sharding tag",
+			},
+			"restricted_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "IDs of the groups allowed to deploy APIs with this tag. Everyone can use the tag when empty",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+// validateShardingTags checks that the tags of the attribute exist in the organization.
+// Tags created in the same run are referenced by their ID, unknown until apply, and skipped.
+func validateShardingTags(d *schema.ResourceDiff, client *gravitee.Client, attribute string) error {
+	if !d.HasChange(attribute) || !d.NewValueKnown(attribute) {
+		return nil
+	}
+
+	tags := d.Get(attribute).(*schema.Set).List()
+	if len(tags) == 0 {
+		return nil
+	}
+
+	shardingTags, err := client.ListShardingTags()
+	if err != nil {
+		return err
+	}
+
+	return checkShardingTags(shardingTags, expandStringList(tags), attribute)
+}
+
+// checkShardingTags checks that every tag is the ID of one of the sharding tags
+func checkShardingTags(shardingTags []gravitee.ShardingTag, tags []string, attribute string) error {
+	known := make(map[string]bool, len(shardingTags))
+	ids := make([]string, 0, len(shardingTags))
+	for _, tag := range shardingTags {
+		known[tag.ID] = true
+		ids = append(ids, tag.ID)
+	}
+	sort.Strings(ids)
+
+	for _, tag := range tags {
+		if !known[tag] {
+			return fmt.Errorf("unknown sharding tag %q in %s, expected one of: %s", tag, attribute, strings.Join(ids, ", "))
+		}
+	}
+
+	return nil
+}
+
+func resourceGraviteeShardingTagCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	tag := expandShardingTag(d)
+
+	createdTag, err := client.CreateShardingTag(tag)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdTag.ID)
+
+	return resourceGraviteeShardingTagRead(ctx, d, m)
+}
+
+func resourceGraviteeShardingTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	tag, err := client.GetShardingTag(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if tag == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Flatten the Sharding Tag object and set to ResourceData
+	if err := flattenShardingTag(d, tag); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGraviteeShardingTagUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	tag := expandShardingTag(d)
+	tag.ID = d.Id()
+
+	err := client.UpdateShardingTag(tag)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGraviteeShardingTagRead(ctx, d, m)
+}
+
+func resourceGraviteeShardingTagDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	err := client.DeleteShardingTag(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper functions for expanding and flattening Sharding Tag objects
+func expandShardingTag(d *schema.ResourceData) *gravitee.ShardingTag {
+	return &gravitee.ShardingTag{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		RestrictedGroups: expandStringList(d.Get("restricted_groups").(*schema.Set).List()),
+	}
+}
+
+func flattenShardingTag(d *schema.ResourceData, tag *gravitee.ShardingTag) error {
+	d.Set("name", tag.Name)
+	d.Set("description", tag.Description)
+
+	if err := d.Set("restricted_groups", tag.RestrictedGroups); err != nil {
+		return err
+	}
+
+	return nil
+}
\ No newline at end of file
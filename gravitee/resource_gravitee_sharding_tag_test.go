@@ -0,0 +1,52 @@
+// resource_gravitee_sharding_tag_test.go
+package gravitee
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestCheckShardingTags(t *testing.T) {
+	shardingTags := []gravitee.ShardingTag{
+		{ID: "internal", Name: "Internal"},
+		{ID: "external", Name: "External"},
+	}
+
+	if err := checkShardingTags(shardingTags, []string{"internal", "external"}, "tags"); err != nil {
+		t.Errorf("unexpected error for known tags: %v", err)
+	}
+
+	if err := checkShardingTags(shardingTags, []string{}, "tags"); err != nil {
+		t.Errorf("unexpected error without tags: %v", err)
+	}
+
+	err := checkShardingTags(shardingTags, []string{"internal", "Internal"}, "tags")
+	if err == nil {
+		t.Fatal("expected an error for a tag referenced by name")
+	}
+
+	if !strings.Contains(err.Error(), `"Internal"`) || !strings.Contains(err.Error(), "external, internal") {
+		t.Errorf("unexpected error message: %v", err)
+	}
+}
+
+func TestShardingTagsAreAlwaysSent(t *testing.T) {
+	body, err := json.Marshal(gravitee.Plan{Tags: expandStringList(nil)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(body), `"tags":[]`) {
+		t.Errorf("expected an empty tags array in %s", body)
+	}
+
+	body, err = json.Marshal(gravitee.API{Tags: expandStringList(nil)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(body), `"tags":[]`) {
+		t.Errorf("expected an empty tags array in %s", body)
+	}
+}
\ No newline at end of file
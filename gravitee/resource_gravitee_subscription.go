@@ -3,10 +3,16 @@ package gravitee
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceGraviteeSubscription() *schema.Resource {
@@ -15,6 +21,7 @@ func resourceGraviteeSubscription() *schema.Resource {
 		ReadContext:   resourceGraviteeSubscriptionRead,
 		UpdateContext: resourceGraviteeSubscriptionUpdate,
 		DeleteContext: resourceGraviteeSubscriptionDelete,
+		CustomizeDiff: resourceGraviteeSubscriptionCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -47,6 +54,98 @@ func resourceGraviteeSubscription() *schema.Resource {
 				Default:     true,
 				Description: "Whether to automatically validate the subscription",
 			},
+			"wait_for_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ACCEPTED", "PENDING"}, false),
+				Description:  "Status to wait for after the subscription is created or transferred. Defaults to ACCEPTED when auto_validate is true",
+			},
+			"reject_reason": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reason used to reject the subscription while it is pending. Only applies when auto_validate is false",
+			},
+			"paused": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the subscription is paused",
+			},
+			"ending_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.Any(validation.IsRFC3339Time, validation.StringIsEmpty),
+				DiffSuppressFunc: suppressEquivalentTimestamps,
+				Description:      "RFC3339 date at which the subscription ends. An end date set outside of Terraform is kept when it is not configured, set it to an empty string to clear it",
+			},
+			"starting_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date at which the subscription started",
+			},
+			"paused_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date at which the subscription was paused",
+			},
+			"publisher_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Message left by the API publisher when accepting or rejecting the subscription",
+			},
+			"custom_api_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Custom API key value for API_KEY plans. Changing it renews the key with the new value",
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value, changing it renews the API key of the subscription",
+			},
+			"api_key_grace_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "1h",
+				ValidateFunc: validateDuration,
+				Description:  "How long previous API keys remain valid after a renewal. They are not revoked but get an expiration date at the end of the grace period, after which Gravitee rejects them. Use 0s to revoke them immediately",
+			},
+			"api_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "API keys of the subscription. Empty unless the plan is an API_KEY plan",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"expire_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"revoked": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"expired": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"consumer_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -61,10 +160,19 @@ func resourceGraviteeSubscription() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"entrypoint_configuration_json": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+							ExactlyOneOf:     []string{"consumer_configuration.0.entrypoint_configuration", "consumer_configuration.0.entrypoint_configuration_json"},
+							Description:      "Entrypoint configuration as JSON, for entrypoints without a typed entrypoint_configuration block",
+						},
 						"entrypoint_configuration": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 1,
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"consumer_configuration.0.entrypoint_configuration", "consumer_configuration.0.entrypoint_configuration_json"},
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"callback_url": {
@@ -87,6 +195,42 @@ func resourceGraviteeSubscription() *schema.Resource {
 											},
 										},
 									},
+									"auth": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										MaxItems:    1,
+										Elem:        webhookAuthSchema(),
+										Description: "Authentication used to call the webhook",
+									},
+									"ssl": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										MaxItems:    1,
+										Elem:        webhookSSLSchema(),
+										Description: "SSL options used to call the webhook",
+									},
+									"retry": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										MaxItems:    1,
+										Elem:        webhookRetrySchema(),
+										Description: "Retry policy applied when a webhook call fails",
+									},
+									"dlq": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"endpoint": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "Endpoint receiving the messages that could not be delivered",
+												},
+											},
+										},
+										Description: "Dead letter queue for messages that could not be delivered",
+									},
 								},
 							},
 						},
@@ -110,6 +254,276 @@ func resourceGraviteeSubscription() *schema.Resource {
 	}
 }
 
+func webhookAuthSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"none", "basic", "token", "oauth2"}, false),
+				Description:  "Authentication type (none, basic, token, oauth2)",
+			},
+			"basic": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"token": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"oauth2": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"client_secret": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"scopes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func webhookSSLSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"hostname_verifier": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"trust_all": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"trust_store": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"PEM", "JKS", "PKCS12"}, false),
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"key_store": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"PEM", "JKS", "PKCS12"}, false),
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"cert_content": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"key_content": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func webhookRetrySchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"retry_option": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Retry On Fail",
+				ValidateFunc: validation.StringInSlice([]string{"No Retry", "Retry On Fail"}, false),
+				Description:  "Whether failed calls are retried (No Retry, Retry On Fail)",
+			},
+			"strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "LINEAR",
+				ValidateFunc: validation.StringInSlice([]string{"LINEAR", "EXPONENTIAL"}, false),
+				Description:  "Retry strategy (LINEAR, EXPONENTIAL)",
+			},
+			"max_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of attempts",
+			},
+			"initial_delay_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Delay before the first retry, in seconds",
+			},
+			"max_delay_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum delay between two retries, in seconds",
+			},
+		},
+	}
+}
+
+// resourceGraviteeSubscriptionCustomizeDiff checks the status to wait for and that the subscription
+// can be paused, then validates the JSON entrypoint configuration against the subscription schema
+// of the entrypoint plugin, when the plugin exposes one
+// planUsesAPIKeys tells whether the subscriptions to the plan are given API keys
+func planUsesAPIKeys(plan *gravitee.Plan) bool {
+	return plan != nil && plan.Security != nil && plan.Security.Type == "API_KEY"
+}
+
+// endingAtCleared tells whether the configuration sets ending_at to an empty string to clear the end date
+func endingAtCleared(config cty.Value) bool {
+	if !config.IsKnown() || config.IsNull() || !config.Type().IsObjectType() || !config.Type().HasAttribute("ending_at") {
+		return false
+	}
+
+	endingAt := config.GetAttr("ending_at")
+	return endingAt.IsKnown() && !endingAt.IsNull() && endingAt.AsString() == ""
+}
+
+func resourceGraviteeSubscriptionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if err := validateSubscriptionWaitStatus(d.Get("wait_for_status").(string), d.Get("auto_validate").(bool)); err != nil {
+		return err
+	}
+
+	// The SDK treats an empty ending_at as unset, keeping the computed end date
+	if d.Id() != "" && d.Get("ending_at").(string) != "" && endingAtCleared(d.GetRawConfig()) {
+		if err := d.SetNew("ending_at", ""); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("paused") && d.Get("paused").(bool) {
+		// The subscription is accepted before being paused when it is created, transferred or auto-validated
+		status := d.Get("status").(string)
+		accepting := (d.Id() == "" || d.HasChange("plan_id") || d.HasChange("auto_validate")) && d.Get("auto_validate").(bool)
+		if err := validateSubscriptionPause(status, accepting); err != nil {
+			return err
+		}
+	}
+
+	rawConfig, ok := d.GetOk("consumer_configuration.0.entrypoint_configuration_json")
+	if !ok || !d.HasChange("consumer_configuration.0.entrypoint_configuration_json") {
+		return nil
+	}
+
+	if !d.NewValueKnown("consumer_configuration.0.entrypoint_configuration_json") || !d.NewValueKnown("consumer_configuration.0.entrypoint_id") {
+		return nil
+	}
+
+	client := m.(*gravitee.Client)
+	entrypointID := d.Get("consumer_configuration.0.entrypoint_id").(string)
+
+	subscriptionSchema, err := client.GetEntrypointSubscriptionSchema(entrypointID)
+	if err != nil {
+		return err
+	}
+
+	if subscriptionSchema == nil {
+		return nil
+	}
+
+	entrypointConfig, err := structure.ExpandJsonFromString(rawConfig.(string))
+	if err != nil {
+		return err
+	}
+
+	if err := validateEntrypointConfiguration(entrypointConfig, subscriptionSchema); err != nil {
+		return fmt.Errorf("invalid entrypoint_configuration_json for entrypoint %s: %s", entrypointID, err)
+	}
+
+	return nil
+}
+
 func resourceGraviteeSubscriptionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*gravitee.Client)
 	apiID := d.Get("api_id").(string)
@@ -124,13 +538,48 @@ func resourceGraviteeSubscriptionCreate(ctx context.Context, d *schema.ResourceD
 	d.SetId(createdSubscription.ID)
 
 	// Auto-validate the subscription if enabled
-	if d.Get("auto_validate").(bool) && createdSubscription.Status != "ACCEPTED" {
+	autoValidate := d.Get("auto_validate").(bool)
+	if autoValidate && createdSubscription.Status != "ACCEPTED" {
 		err = client.AcceptSubscription(apiID, createdSubscription.ID, "Auto-approved by Terraform")
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
+	// Reject the pending subscription if a reason is given, nothing else applies to it afterwards
+	if v, ok := d.GetOk("reject_reason"); ok && !autoValidate {
+		err = client.RejectSubscription(apiID, createdSubscription.ID, v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceGraviteeSubscriptionRead(ctx, d, m)
+	}
+
+	// Wait for the subscription to be processed before applying further changes
+	if status := subscriptionTargetStatus(d); status != "" {
+		err = waitForSubscriptionStatus(ctx, client, apiID, createdSubscription.ID, status, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// The end date can only be set once the subscription exists
+	if v, ok := d.GetOk("ending_at"); ok {
+		subscription.ID = createdSubscription.ID
+		subscription.EndingAt = v.(string)
+		err = client.UpdateSubscription(apiID, subscription, true)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("paused").(bool) {
+		err = client.PauseSubscription(apiID, createdSubscription.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceGraviteeSubscriptionRead(ctx, d, m)
 }
 
@@ -153,6 +602,26 @@ func resourceGraviteeSubscriptionRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
+	plan, err := client.GetPlan(apiID, subscription.PlanID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only subscriptions to API_KEY plans have keys
+	if !planUsesAPIKeys(plan) {
+		d.Set("api_keys", nil)
+		return nil
+	}
+
+	apiKeys, err := client.ListSubscriptionAPIKeys(apiID, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("api_keys", flattenAPIKeys(apiKeys)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -160,12 +629,13 @@ func resourceGraviteeSubscriptionUpdate(ctx context.Context, d *schema.ResourceD
 	client := m.(*gravitee.Client)
 	apiID := d.Get("api_id").(string)
 
-	// Check if consumer configuration or metadata is being changed
-	if d.HasChange("consumer_configuration") || d.HasChange("metadata") {
+	// Check if consumer configuration, metadata or end date is being changed
+	if d.HasChange("consumer_configuration") || d.HasChange("metadata") || d.HasChange("ending_at") {
 		subscription := expandSubscription(d)
 		subscription.ID = d.Id()
+		subscription.EndingAt = d.Get("ending_at").(string)
 
-		err := client.UpdateSubscription(apiID, subscription)
+		err := client.UpdateSubscription(apiID, subscription, d.HasChange("ending_at"))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -186,6 +656,61 @@ func resourceGraviteeSubscriptionUpdate(ctx context.Context, d *schema.ResourceD
 				return diag.FromErr(err)
 			}
 		}
+
+		if status := subscriptionTargetStatus(d); status != "" {
+			err = waitForSubscriptionStatus(ctx, client, apiID, d.Id(), status, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	// Process a pending subscription that is now being accepted or rejected
+	if d.Get("status").(string) == "PENDING" {
+		if d.HasChange("auto_validate") && d.Get("auto_validate").(bool) {
+			err := client.AcceptSubscription(apiID, d.Id(), "Auto-approved by Terraform")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			err = waitForSubscriptionStatus(ctx, client, apiID, d.Id(), subscriptionTargetStatus(d), d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		} else if v, ok := d.GetOk("reject_reason"); ok && d.HasChange("reject_reason") && !d.Get("auto_validate").(bool) {
+			err := client.RejectSubscription(apiID, d.Id(), v.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return resourceGraviteeSubscriptionRead(ctx, d, m)
+		}
+	}
+
+	// Check if the subscription is being paused or resumed
+	if d.HasChange("paused") {
+		var err error
+		if d.Get("paused").(bool) {
+			err = client.PauseSubscription(apiID, d.Id())
+		} else {
+			err = client.ResumeSubscription(apiID, d.Id())
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Rotate the API key when the trigger or the custom key value changes
+	if d.HasChange("rotation_trigger") || d.HasChange("custom_api_key") {
+		customAPIKey := ""
+		if d.HasChange("custom_api_key") {
+			customAPIKey = d.Get("custom_api_key").(string)
+		}
+
+		gracePeriod, _ := time.ParseDuration(d.Get("api_key_grace_period").(string))
+		err := rotateSubscriptionAPIKey(client, apiID, d.Id(), customAPIKey, gracePeriod)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceGraviteeSubscriptionRead(ctx, d, m)
@@ -209,6 +734,7 @@ func expandSubscription(d *schema.ResourceData) *gravitee.Subscription {
 	subscription := &gravitee.Subscription{
 		PlanID:        d.Get("plan_id").(string),
 		ApplicationID: d.Get("application_id").(string),
+		CustomAPIKey:  d.Get("custom_api_key").(string),
 	}
 
 	if v, ok := d.GetOk("consumer_configuration"); ok && len(v.([]interface{})) > 0 {
@@ -236,6 +762,11 @@ func expandConsumerConfiguration(config map[string]interface{}) *gravitee.Consum
 		consumerConfig.Channel = v.(string)
 	}
 
+	if v, ok := config["entrypoint_configuration_json"]; ok && v.(string) != "" {
+		consumerConfig.RawEntrypointConfiguration = json.RawMessage(v.(string))
+		return consumerConfig
+	}
+
 	if v, ok := config["entrypoint_configuration"]; ok && len(v.([]interface{})) > 0 {
 		entrypointConfig := v.([]interface{})[0].(map[string]interface{})
 
@@ -254,18 +785,125 @@ func expandConsumerConfiguration(config map[string]interface{}) *gravitee.Consum
 			}
 			consumerConfig.EntrypointConfiguration.Headers = headers
 		}
+
+		if v, ok := entrypointConfig["auth"]; ok && len(v.([]interface{})) > 0 {
+			consumerConfig.EntrypointConfiguration.Auth = expandWebhookAuth(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := entrypointConfig["ssl"]; ok && len(v.([]interface{})) > 0 {
+			consumerConfig.EntrypointConfiguration.SSL = expandWebhookSSL(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := entrypointConfig["retry"]; ok && len(v.([]interface{})) > 0 {
+			retryConfig := v.([]interface{})[0].(map[string]interface{})
+			consumerConfig.EntrypointConfiguration.Retry = &gravitee.WebhookRetry{
+				RetryOption:         retryConfig["retry_option"].(string),
+				RetryStrategy:       retryConfig["strategy"].(string),
+				MaxAttempts:         retryConfig["max_attempts"].(int),
+				InitialDelaySeconds: retryConfig["initial_delay_seconds"].(int),
+				MaxDelaySeconds:     retryConfig["max_delay_seconds"].(int),
+			}
+		}
+
+		if v, ok := entrypointConfig["dlq"]; ok && len(v.([]interface{})) > 0 {
+			dlqConfig := v.([]interface{})[0].(map[string]interface{})
+			consumerConfig.EntrypointConfiguration.DLQ = &gravitee.WebhookDLQ{
+				Endpoint: dlqConfig["endpoint"].(string),
+			}
+		}
 	}
 
 	return consumerConfig
 }
 
+func expandWebhookAuth(config map[string]interface{}) *gravitee.WebhookAuth {
+	auth := &gravitee.WebhookAuth{
+		Type: config["type"].(string),
+	}
+
+	if v, ok := config["basic"]; ok && len(v.([]interface{})) > 0 {
+		basic := v.([]interface{})[0].(map[string]interface{})
+		auth.Basic = &gravitee.WebhookBasicAuth{
+			Username: basic["username"].(string),
+			Password: basic["password"].(string),
+		}
+	}
+
+	if v, ok := config["token"]; ok && len(v.([]interface{})) > 0 {
+		token := v.([]interface{})[0].(map[string]interface{})
+		auth.Token = &gravitee.WebhookTokenAuth{
+			Value: token["value"].(string),
+		}
+	}
+
+	if v, ok := config["oauth2"]; ok && len(v.([]interface{})) > 0 {
+		oauth2 := v.([]interface{})[0].(map[string]interface{})
+		auth.OAuth2 = &gravitee.WebhookOAuth2Auth{
+			Endpoint:     oauth2["endpoint"].(string),
+			ClientID:     oauth2["client_id"].(string),
+			ClientSecret: oauth2["client_secret"].(string),
+		}
+
+		if scopesRaw, ok := oauth2["scopes"]; ok {
+			scopes := make([]string, 0)
+			for _, scope := range scopesRaw.([]interface{}) {
+				scopes = append(scopes, scope.(string))
+			}
+			auth.OAuth2.Scopes = scopes
+		}
+	}
+
+	return auth
+}
+
+func expandWebhookSSL(config map[string]interface{}) *gravitee.WebhookSSL {
+	ssl := &gravitee.WebhookSSL{
+		HostnameVerifier: config["hostname_verifier"].(bool),
+		TrustAll:         config["trust_all"].(bool),
+	}
+
+	if v, ok := config["trust_store"]; ok && len(v.([]interface{})) > 0 {
+		trustStore := v.([]interface{})[0].(map[string]interface{})
+		ssl.TrustStore = &gravitee.WebhookTrustStore{
+			Type:     trustStore["type"].(string),
+			Path:     trustStore["path"].(string),
+			Content:  trustStore["content"].(string),
+			Password: trustStore["password"].(string),
+		}
+	}
+
+	if v, ok := config["key_store"]; ok && len(v.([]interface{})) > 0 {
+		keyStore := v.([]interface{})[0].(map[string]interface{})
+		ssl.KeyStore = &gravitee.WebhookKeyStore{
+			Type:        keyStore["type"].(string),
+			Path:        keyStore["path"].(string),
+			Content:     keyStore["content"].(string),
+			Password:    keyStore["password"].(string),
+			CertContent: keyStore["cert_content"].(string),
+			KeyContent:  keyStore["key_content"].(string),
+		}
+	}
+
+	return ssl
+}
+
 func flattenSubscription(d *schema.ResourceData, subscription *gravitee.Subscription) error {
 	d.Set("plan_id", subscription.PlanID)
 	d.Set("application_id", subscription.ApplicationID)
 	d.Set("status", subscription.Status)
+	d.Set("paused", subscription.Status == "PAUSED")
+	d.Set("ending_at", subscription.EndingAt)
+	d.Set("starting_at", subscription.StartingAt)
+	d.Set("paused_at", subscription.PausedAt)
+	d.Set("publisher_message", subscription.PublisherMessage)
 
 	if subscription.ConsumerConfiguration != nil {
-		consumerConfig := flattenConsumerConfiguration(subscription.ConsumerConfiguration)
+		// Keep the JSON form when it is used in the configuration or when the entrypoint has no typed model
+		_, useJSON := d.GetOk("consumer_configuration.0.entrypoint_configuration_json")
+		useJSON = useJSON || subscription.ConsumerConfiguration.EntrypointID != "webhook"
+
+		consumerConfig := flattenConsumerConfiguration(subscription.ConsumerConfiguration, useJSON)
+		preserveWebhookSecrets(consumerConfig, d.Get("consumer_configuration").([]interface{}))
 		if err := d.Set("consumer_configuration", []interface{}{consumerConfig}); err != nil {
 			return err
 		}
@@ -280,7 +918,7 @@ func flattenSubscription(d *schema.ResourceData, subscription *gravitee.Subscrip
 	return nil
 }
 
-func flattenConsumerConfiguration(config *gravitee.ConsumerConfiguration) map[string]interface{} {
+func flattenConsumerConfiguration(config *gravitee.ConsumerConfiguration, useJSON bool) map[string]interface{} {
 	result := map[string]interface{}{
 		"entrypoint_id": config.EntrypointID,
 	}
@@ -289,13 +927,20 @@ func flattenConsumerConfiguration(config *gravitee.ConsumerConfiguration) map[st
 		result["channel"] = config.Channel
 	}
 
+	if useJSON {
+		if len(config.RawEntrypointConfiguration) > 0 {
+			result["entrypoint_configuration_json"] = string(config.RawEntrypointConfiguration)
+		}
+		return result
+	}
+
 	if config.EntrypointConfiguration != nil {
 		entrypointConfig := map[string]interface{}{
 			"callback_url": config.EntrypointConfiguration.CallbackURL,
 		}
 
 		if len(config.EntrypointConfiguration.Headers) > 0 {
-			headers := make([]map[string]interface{}, len(config.EntrypointConfiguration.Headers))
+			headers := make([]interface{}, len(config.EntrypointConfiguration.Headers))
 			for i, header := range config.EntrypointConfiguration.Headers {
 				headers[i] = map[string]interface{}{
 					"name":  header.Name,
@@ -305,8 +950,372 @@ func flattenConsumerConfiguration(config *gravitee.ConsumerConfiguration) map[st
 			entrypointConfig["headers"] = headers
 		}
 
+		if config.EntrypointConfiguration.Auth != nil {
+			entrypointConfig["auth"] = []interface{}{flattenWebhookAuth(config.EntrypointConfiguration.Auth)}
+		}
+
+		if config.EntrypointConfiguration.SSL != nil {
+			entrypointConfig["ssl"] = []interface{}{flattenWebhookSSL(config.EntrypointConfiguration.SSL)}
+		}
+
+		if retryConfig := config.EntrypointConfiguration.Retry; retryConfig != nil {
+			entrypointConfig["retry"] = []interface{}{map[string]interface{}{
+				"retry_option":          retryConfig.RetryOption,
+				"strategy":              retryConfig.RetryStrategy,
+				"max_attempts":          retryConfig.MaxAttempts,
+				"initial_delay_seconds": retryConfig.InitialDelaySeconds,
+				"max_delay_seconds":     retryConfig.MaxDelaySeconds,
+			}}
+		}
+
+		if config.EntrypointConfiguration.DLQ != nil {
+			entrypointConfig["dlq"] = []interface{}{map[string]interface{}{
+				"endpoint": config.EntrypointConfiguration.DLQ.Endpoint,
+			}}
+		}
+
 		result["entrypoint_configuration"] = []interface{}{entrypointConfig}
 	}
 
 	return result
+}
+
+func flattenWebhookAuth(auth *gravitee.WebhookAuth) map[string]interface{} {
+	result := map[string]interface{}{
+		"type": auth.Type,
+	}
+
+	if auth.Basic != nil {
+		result["basic"] = []interface{}{map[string]interface{}{
+			"username": auth.Basic.Username,
+			"password": auth.Basic.Password,
+		}}
+	}
+
+	if auth.Token != nil {
+		result["token"] = []interface{}{map[string]interface{}{
+			"value": auth.Token.Value,
+		}}
+	}
+
+	if auth.OAuth2 != nil {
+		result["oauth2"] = []interface{}{map[string]interface{}{
+			"endpoint":      auth.OAuth2.Endpoint,
+			"client_id":     auth.OAuth2.ClientID,
+			"client_secret": auth.OAuth2.ClientSecret,
+			"scopes":        flattenStringList(auth.OAuth2.Scopes),
+		}}
+	}
+
+	return result
+}
+
+func flattenWebhookSSL(ssl *gravitee.WebhookSSL) map[string]interface{} {
+	result := map[string]interface{}{
+		"hostname_verifier": ssl.HostnameVerifier,
+		"trust_all":         ssl.TrustAll,
+	}
+
+	if ssl.TrustStore != nil {
+		result["trust_store"] = []interface{}{map[string]interface{}{
+			"type":     ssl.TrustStore.Type,
+			"path":     ssl.TrustStore.Path,
+			"content":  ssl.TrustStore.Content,
+			"password": ssl.TrustStore.Password,
+		}}
+	}
+
+	if ssl.KeyStore != nil {
+		result["key_store"] = []interface{}{map[string]interface{}{
+			"type":         ssl.KeyStore.Type,
+			"path":         ssl.KeyStore.Path,
+			"content":      ssl.KeyStore.Content,
+			"password":     ssl.KeyStore.Password,
+			"cert_content": ssl.KeyStore.CertContent,
+			"key_content":  ssl.KeyStore.KeyContent,
+		}}
+	}
+
+	return result
+}
+
+// webhookSecretPaths lists the sensitive attributes of the webhook consumer configuration
+var webhookSecretPaths = [][]string{
+	{"entrypoint_configuration", "auth", "basic", "password"},
+	{"entrypoint_configuration", "auth", "token", "value"},
+	{"entrypoint_configuration", "auth", "oauth2", "client_secret"},
+	{"entrypoint_configuration", "ssl", "trust_store", "content"},
+	{"entrypoint_configuration", "ssl", "trust_store", "password"},
+	{"entrypoint_configuration", "ssl", "key_store", "content"},
+	{"entrypoint_configuration", "ssl", "key_store", "password"},
+	{"entrypoint_configuration", "ssl", "key_store", "key_content"},
+}
+
+// preserveWebhookSecrets keeps the secrets of the prior consumer configuration in the flattened one,
+// since Gravitee may return them masked
+func preserveWebhookSecrets(consumerConfig map[string]interface{}, prior []interface{}) {
+	if len(prior) == 0 || prior[0] == nil {
+		return
+	}
+
+	priorConfig := prior[0].(map[string]interface{})
+	for _, path := range webhookSecretPaths {
+		parentPath, key := path[:len(path)-1], path[len(path)-1]
+
+		priorBlock := nestedBlock(priorConfig, parentPath)
+		if priorBlock == nil {
+			continue
+		}
+
+		value, _ := priorBlock[key].(string)
+		if value == "" {
+			continue
+		}
+
+		if block := nestedBlock(consumerConfig, parentPath); block != nil {
+			block[key] = value
+		}
+	}
+}
+
+// nestedBlock follows a path of single nested blocks and returns the last one, or nil when one is missing
+func nestedBlock(config map[string]interface{}, path []string) map[string]interface{} {
+	for _, key := range path {
+		blocks, ok := config[key].([]interface{})
+		if !ok || len(blocks) == 0 {
+			return nil
+		}
+
+		config, ok = blocks[0].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+	}
+
+	return config
+}
+
+// validateEntrypointConfiguration checks the required properties, property types and
+// additional properties of an entrypoint configuration against a JSON schema
+func validateEntrypointConfiguration(config map[string]interface{}, subscriptionSchema map[string]interface{}) error {
+	if required, ok := subscriptionSchema["required"].([]interface{}); ok {
+		for _, name := range required {
+			if _, ok := config[name.(string)]; !ok {
+				return fmt.Errorf("missing required property %q", name)
+			}
+		}
+	}
+
+	properties, _ := subscriptionSchema["properties"].(map[string]interface{})
+	for name, value := range config {
+		property, ok := properties[name].(map[string]interface{})
+		if !ok {
+			if additional, ok := subscriptionSchema["additionalProperties"].(bool); ok && !additional {
+				return fmt.Errorf("unknown property %q", name)
+			}
+			continue
+		}
+
+		propertyType, ok := property["type"].(string)
+		if !ok || value == nil {
+			continue
+		}
+
+		if !matchesJSONSchemaType(value, propertyType) {
+			return fmt.Errorf("property %q must be of type %s", name, propertyType)
+		}
+	}
+
+	return nil
+}
+
+// matchesJSONSchemaType reports whether a decoded JSON value matches a JSON schema type
+func matchesJSONSchemaType(value interface{}, schemaType string) bool {
+	switch schemaType {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "integer":
+		number, ok := value.(float64)
+		return ok && number == float64(int64(number))
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	}
+
+	return true
+}
+
+// validateSubscriptionPause checks that a subscription is accepted, or about to be, before it is
+// paused since Gravitee refuses to pause a subscription in any other status
+func validateSubscriptionPause(status string, accepting bool) error {
+	if accepting || status == "ACCEPTED" || status == "PAUSED" {
+		return nil
+	}
+
+	if status == "" {
+		return fmt.Errorf("a new subscription can only be paused when auto_validate is true")
+	}
+
+	return fmt.Errorf("only ACCEPTED subscriptions can be paused, subscription is %s", status)
+}
+
+// validateSubscriptionWaitStatus checks that the status to wait for can be reached, an auto-validated
+// subscription never stays PENDING
+func validateSubscriptionWaitStatus(waitForStatus string, autoValidate bool) error {
+	if waitForStatus == "PENDING" && autoValidate {
+		return fmt.Errorf("wait_for_status cannot be PENDING when auto_validate is true")
+	}
+
+	return nil
+}
+
+// subscriptionTargetStatus returns the status to wait for once the subscription is processed,
+// or an empty string when there is nothing to wait for
+func subscriptionTargetStatus(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("wait_for_status"); ok {
+		return v.(string)
+	}
+
+	if d.Get("auto_validate").(bool) {
+		return "ACCEPTED"
+	}
+
+	return ""
+}
+
+// subscriptionWaitStates returns the pending and target statuses to wait for a given status. A plan
+// with automatic validation accepts its subscriptions directly, so they never show as PENDING
+func subscriptionWaitStates(status string) ([]string, []string) {
+	if status == "PENDING" {
+		return []string{}, []string{"PENDING", "ACCEPTED"}
+	}
+
+	return []string{"PENDING"}, []string{status}
+}
+
+// waitForSubscriptionStatus polls the subscription until it reaches the given status
+func waitForSubscriptionStatus(ctx context.Context, client *gravitee.Client, apiID string, subscriptionID string, status string, timeout time.Duration) error {
+	pending, target := subscriptionWaitStates(status)
+
+	stateConf := &retry.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			subscription, err := client.GetSubscription(apiID, subscriptionID)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if subscription == nil {
+				return nil, "", fmt.Errorf("subscription %s not found", subscriptionID)
+			}
+
+			if subscription.Status == "REJECTED" {
+				return subscription, subscription.Status, fmt.Errorf("subscription %s was rejected: %s", subscriptionID, subscription.PublisherMessage)
+			}
+
+			return subscription, subscription.Status, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// rotateSubscriptionAPIKey renews the API key of a subscription. The previous keys are revoked
+// right away without a grace period, otherwise they are set to expire at the end of it rather
+// than being revoked later, since nothing runs once the apply is over
+func rotateSubscriptionAPIKey(client *gravitee.Client, apiID string, subscriptionID string, customAPIKey string, gracePeriod time.Duration) error {
+	previousKeys, err := client.ListSubscriptionAPIKeys(apiID, subscriptionID)
+	if err != nil {
+		return err
+	}
+
+	renewedKey, err := client.RenewSubscriptionAPIKey(apiID, subscriptionID, customAPIKey)
+	if err != nil {
+		return err
+	}
+
+	expireAt := time.Now().Add(gracePeriod)
+	for _, apiKey := range previousKeys {
+		if apiKey.Revoked || apiKey.Expired || apiKey.ID == renewedKey.ID {
+			continue
+		}
+
+		if gracePeriod == 0 {
+			err = client.RevokeSubscriptionAPIKey(apiID, subscriptionID, apiKey.ID)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Keep an expiration date that is already earlier than the end of the grace period
+		if currentExpireAt, err := time.Parse(time.RFC3339, apiKey.ExpireAt); err == nil && currentExpireAt.Before(expireAt) {
+			continue
+		}
+
+		apiKey.ExpireAt = expireAt.UTC().Format(time.RFC3339)
+		err = client.UpdateSubscriptionAPIKey(apiID, subscriptionID, &apiKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func flattenAPIKeys(apiKeys []gravitee.APIKey) []interface{} {
+	result := make([]interface{}, len(apiKeys))
+	for i, apiKey := range apiKeys {
+		result[i] = map[string]interface{}{
+			"id":         apiKey.ID,
+			"key":        apiKey.Key,
+			"expire_at":  apiKey.ExpireAt,
+			"revoked":    apiKey.Revoked,
+			"expired":    apiKey.Expired,
+			"created_at": apiKey.CreatedAt,
+		}
+	}
+
+	return result
+}
+
+// validateDuration checks that a value is a non-negative duration such as "30m" or "2h"
+func validateDuration(v interface{}, k string) (ws []string, errs []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%q must be a valid duration (e.g. 30m, 2h): %s", k, err))
+	} else if duration < 0 {
+		errs = append(errs, fmt.Errorf("%q must not be negative", k))
+	}
+
+	return ws, errs
+}
+
+// suppressEquivalentTimestamps ignores differences between two RFC3339 dates denoting the same instant
+func suppressEquivalentTimestamps(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
 }
\ No newline at end of file
@@ -0,0 +1,217 @@
+// resource_gravitee_subscription_test.go
+package gravitee
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestValidateSubscriptionPause(t *testing.T) {
+	cases := []struct {
+		status    string
+		accepting bool
+		wantErr   bool
+	}{
+		{status: "ACCEPTED", wantErr: false},
+		{status: "PAUSED", wantErr: false},
+		{status: "PENDING", accepting: true, wantErr: false},
+		{status: "PENDING", wantErr: true},
+		{status: "REJECTED", wantErr: true},
+		{status: "CLOSED", wantErr: true},
+		{status: "", accepting: true, wantErr: false},
+		{status: "", wantErr: true},
+	}
+
+	for _, c := range cases {
+		err := validateSubscriptionPause(c.status, c.accepting)
+		if (err != nil) != c.wantErr {
+			t.Errorf("validateSubscriptionPause(%q, %t) returned error %v, want error: %t", c.status, c.accepting, err, c.wantErr)
+		}
+	}
+}
+
+func TestValidateSubscriptionWaitStatus(t *testing.T) {
+	if err := validateSubscriptionWaitStatus("PENDING", true); err == nil {
+		t.Error("expected an error when waiting for PENDING with auto_validate")
+	}
+
+	for _, status := range []string{"", "ACCEPTED"} {
+		if err := validateSubscriptionWaitStatus(status, true); err != nil {
+			t.Errorf("unexpected error for %q with auto_validate: %s", status, err)
+		}
+	}
+
+	if err := validateSubscriptionWaitStatus("PENDING", false); err != nil {
+		t.Errorf("unexpected error for PENDING without auto_validate: %s", err)
+	}
+}
+
+func TestSubscriptionWaitStates(t *testing.T) {
+	cases := []struct {
+		status  string
+		pending []string
+		target  []string
+	}{
+		{status: "ACCEPTED", pending: []string{"PENDING"}, target: []string{"ACCEPTED"}},
+		{status: "PENDING", pending: []string{}, target: []string{"PENDING", "ACCEPTED"}},
+	}
+
+	for _, c := range cases {
+		pending, target := subscriptionWaitStates(c.status)
+		if !reflect.DeepEqual(pending, c.pending) || !reflect.DeepEqual(target, c.target) {
+			t.Errorf("subscriptionWaitStates(%q) = %v, %v, want %v, %v", c.status, pending, target, c.pending, c.target)
+		}
+	}
+}
+
+func testWebhookConsumerConfiguration() *gravitee.ConsumerConfiguration {
+	return &gravitee.ConsumerConfiguration{
+		EntrypointID: "webhook",
+		Channel:      "orders",
+		EntrypointConfiguration: &gravitee.EntrypointConfiguration{
+			CallbackURL: "https://example.com/hook",
+			Headers:     []gravitee.Header{{Name: "X-Tenant", Value: "acme"}},
+			Auth: &gravitee.WebhookAuth{
+				Type: "oauth2",
+				OAuth2: &gravitee.WebhookOAuth2Auth{
+					Endpoint:     "https://auth.example.com/token",
+					ClientID:     "client",
+					ClientSecret: "secret",
+					Scopes:       []string{"read", "write"},
+				},
+			},
+			SSL: &gravitee.WebhookSSL{
+				HostnameVerifier: true,
+				TrustStore:       &gravitee.WebhookTrustStore{Type: "PEM", Content: "trusted"},
+				KeyStore:         &gravitee.WebhookKeyStore{Type: "PKCS12", Content: "keys", Password: "changeit"},
+			},
+			Retry: &gravitee.WebhookRetry{
+				RetryOption:         "Retry On Fail",
+				RetryStrategy:       "EXPONENTIAL",
+				MaxAttempts:         5,
+				InitialDelaySeconds: 1,
+				MaxDelaySeconds:     30,
+			},
+			DLQ: &gravitee.WebhookDLQ{Endpoint: "dlq"},
+		},
+	}
+}
+
+func TestWebhookConsumerConfigurationRoundTrip(t *testing.T) {
+	config := testWebhookConsumerConfiguration()
+
+	got := expandConsumerConfiguration(flattenConsumerConfiguration(config, false))
+	if !reflect.DeepEqual(got, config) {
+		t.Errorf("round trip of the webhook configuration = %+v, want %+v", got.EntrypointConfiguration, config.EntrypointConfiguration)
+	}
+}
+
+func TestPreserveWebhookSecrets(t *testing.T) {
+	prior := []interface{}{flattenConsumerConfiguration(testWebhookConsumerConfiguration(), false)}
+
+	masked := testWebhookConsumerConfiguration()
+	masked.EntrypointConfiguration.Auth.OAuth2.ClientSecret = "*****"
+	masked.EntrypointConfiguration.SSL.KeyStore.Password = "*****"
+	masked.EntrypointConfiguration.SSL.TrustStore = nil
+
+	consumerConfig := flattenConsumerConfiguration(masked, false)
+	preserveWebhookSecrets(consumerConfig, prior)
+
+	got := expandConsumerConfiguration(consumerConfig)
+	if got.EntrypointConfiguration.Auth.OAuth2.ClientSecret != "secret" {
+		t.Errorf("client_secret = %q, want the configured value", got.EntrypointConfiguration.Auth.OAuth2.ClientSecret)
+	}
+
+	if got.EntrypointConfiguration.SSL.KeyStore.Password != "changeit" {
+		t.Errorf("key store password = %q, want the configured value", got.EntrypointConfiguration.SSL.KeyStore.Password)
+	}
+
+	if got.EntrypointConfiguration.SSL.TrustStore != nil {
+		t.Errorf("trust store = %+v, want it to stay removed", got.EntrypointConfiguration.SSL.TrustStore)
+	}
+}
+
+func TestConsumerConfigurationJSONRoundTrip(t *testing.T) {
+	for _, config := range []*gravitee.ConsumerConfiguration{
+		testWebhookConsumerConfiguration(),
+		{EntrypointID: "sse"},
+	} {
+		body, err := json.Marshal(config)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		var got gravitee.ConsumerConfiguration
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got.EntrypointID != config.EntrypointID || !reflect.DeepEqual(got.EntrypointConfiguration, config.EntrypointConfiguration) {
+			t.Errorf("round trip of %s = %+v, want %+v", body, got, config)
+		}
+	}
+
+	raw := &gravitee.ConsumerConfiguration{
+		EntrypointID:               "http-get",
+		RawEntrypointConfiguration: json.RawMessage(`{"messagesLimitCount":10}`),
+	}
+
+	body, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got gravitee.ConsumerConfiguration
+	if err := json.Unmarshal(body, &got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if string(got.RawEntrypointConfiguration) != `{"messagesLimitCount":10}` {
+		t.Errorf("raw entrypoint configuration = %s, want it unchanged", got.RawEntrypointConfiguration)
+	}
+}
+
+func TestEndingAtCleared(t *testing.T) {
+	config := func(endingAt cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"ending_at": endingAt})
+	}
+
+	cases := []struct {
+		config cty.Value
+		want   bool
+	}{
+		{config: config(cty.StringVal("")), want: true},
+		{config: config(cty.StringVal("2030-01-01T00:00:00Z")), want: false},
+		{config: config(cty.NullVal(cty.String)), want: false},
+		{config: config(cty.UnknownVal(cty.String)), want: false},
+		{config: cty.NullVal(cty.Object(map[string]cty.Type{"ending_at": cty.String})), want: false},
+		{config: cty.EmptyObjectVal, want: false},
+	}
+
+	for _, c := range cases {
+		if got := endingAtCleared(c.config); got != c.want {
+			t.Errorf("endingAtCleared(%#v) = %t, want %t", c.config, got, c.want)
+		}
+	}
+}
+
+func TestPlanUsesAPIKeys(t *testing.T) {
+	cases := []struct {
+		plan *gravitee.Plan
+		want bool
+	}{
+		{plan: &gravitee.Plan{Security: &gravitee.Security{Type: "API_KEY"}}, want: true},
+		{plan: &gravitee.Plan{Security: &gravitee.Security{Type: "JWT"}}, want: false},
+		{plan: &gravitee.Plan{}, want: false},
+		{plan: nil, want: false},
+	}
+
+	for _, c := range cases {
+		if got := planUsesAPIKeys(c.plan); got != c.want {
+			t.Errorf("planUsesAPIKeys(%#v) = %t, want %t", c.plan, got, c.want)
+		}
+	}
+}
\ No newline at end of file
@@ -0,0 +1,112 @@
+// resource_gravitee_tenant.go
+package gravitee
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGraviteeTenant() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeTenantCreate,
+		ReadContext:   resourceGraviteeTenantRead,
+		UpdateContext: resourceGraviteeTenantUpdate,
+		DeleteContext: resourceGraviteeTenantDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the tenant",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the tenant",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceGraviteeTenantCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	tenant := expandTenant(d)
+
+	createdTenant, err := client.CreateTenant(tenant)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdTenant.ID)
+
+	return resourceGraviteeTenantRead(ctx, d, m)
+}
+
+func resourceGraviteeTenantRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	tenant, err := client.GetTenant(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if tenant == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Flatten the Tenant object and set to ResourceData
+	flattenTenant(d, tenant)
+
+	return nil
+}
+
+func resourceGraviteeTenantUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	tenant := expandTenant(d)
+	tenant.ID = d.Id()
+
+	err := client.UpdateTenant(tenant)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGraviteeTenantRead(ctx, d, m)
+}
+
+func resourceGraviteeTenantDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	err := client.DeleteTenant(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Helper functions for expanding and flattening Tenant objects
+func expandTenant(d *schema.ResourceData) *gravitee.Tenant {
+	return &gravitee.Tenant{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+}
+
+func flattenTenant(d *schema.ResourceData, tenant *gravitee.Tenant) {
+	d.Set("name", tenant.Name)
+	d.Set("description", tenant.Description)
+}
\ No newline at end of file
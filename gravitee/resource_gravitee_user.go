@@ -0,0 +1,279 @@
+// resource_gravitee_user.go
+package gravitee
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGraviteeUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGraviteeUserCreate,
+		ReadContext:   resourceGraviteeUserRead,
+		UpdateContext: resourceGraviteeUserUpdate,
+		DeleteContext: resourceGraviteeUserDelete,
+		CustomizeDiff: resourceGraviteeUserCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"source": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "gravitee",
+				Description: "Identity provider the user comes from",
+			},
+			"source_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the user in its source. Defaults to the email",
+			},
+			"service_account": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the user is a service account, such as a CI pipeline",
+			},
+			"first_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "First name of the user, or name of the service account",
+			},
+			"last_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Last name of the user",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Email of the user",
+			},
+			"organization_roles": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Names of the organization roles of the user. Set it to an empty list to remove every role",
+			},
+			"environment_roles": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Names of the environment roles of the user. Set it to an empty list to remove every role",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the user",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+// resourceGraviteeUserCustomizeDiff plans the removal of every role when the roles are set to an
+// empty list, which computed attributes otherwise treat as not declared
+func resourceGraviteeUserCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	for _, key := range []string{"organization_roles", "environment_roles"} {
+		roles := rawConfig.GetAttr(key)
+		if !roles.IsKnown() || roles.IsNull() || roles.LengthInt() > 0 {
+			continue
+		}
+
+		if d.Get(key).(*schema.Set).Len() > 0 {
+			if err := d.SetNew(key, []interface{}{}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceGraviteeUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	user := expandUser(d)
+	user.Source = d.Get("source").(string)
+	user.SourceID = d.Get("source_id").(string)
+	user.Service = d.Get("service_account").(bool)
+
+	createdUser, err := client.CreateUser(user)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdUser.ID)
+
+	if err := updateUserRoles(client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGraviteeUserRead(ctx, d, m)
+}
+
+func resourceGraviteeUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	user, err := client.GetUser(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if user == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Flatten the User object and set to ResourceData
+	if err := flattenUser(d, user); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGraviteeUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	if d.HasChange("first_name") || d.HasChange("last_name") || d.HasChange("email") {
+		user := expandUser(d)
+		user.ID = d.Id()
+
+		err := client.UpdateUser(user)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := updateUserRoles(client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGraviteeUserRead(ctx, d, m)
+}
+
+func resourceGraviteeUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	err := client.DeleteUser(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// updateUserRoles applies the changes of the organization and environment roles of the user: the
+// roles no longer declared are removed, then the declared roles are assigned when some are new
+func updateUserRoles(client *gravitee.Client, d *schema.ResourceData) error {
+	roles := map[string]string{
+		"ORGANIZATION": "organization_roles",
+		"ENVIRONMENT":  "environment_roles",
+	}
+
+	for referenceType, key := range roles {
+		if !d.HasChange(key) {
+			continue
+		}
+
+		o, n := d.GetChange(key)
+		oldRoles, newRoles := o.(*schema.Set), n.(*schema.Set)
+
+		for _, name := range oldRoles.Difference(newRoles).List() {
+			err := client.DeleteUserRole(referenceType, name.(string), d.Id())
+			if err != nil {
+				return err
+			}
+		}
+
+		if newRoles.Difference(oldRoles).Len() == 0 {
+			continue
+		}
+
+		// Roles are declared by name but assigned by ID
+		roleIDs := make([]string, 0)
+		for _, name := range newRoles.List() {
+			role, err := client.GetRole(referenceType, name.(string))
+			if err != nil {
+				return err
+			}
+
+			if role == nil {
+				return fmt.Errorf("%s role %q not found", referenceType, name)
+			}
+			roleIDs = append(roleIDs, role.ID)
+		}
+
+		err := client.UpdateUserRoles(d.Id(), referenceType, "DEFAULT", roleIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Helper functions for expanding and flattening User objects
+func expandUser(d *schema.ResourceData) *gravitee.User {
+	return &gravitee.User{
+		FirstName: d.Get("first_name").(string),
+		LastName:  d.Get("last_name").(string),
+		Email:     d.Get("email").(string),
+	}
+}
+
+func flattenUser(d *schema.ResourceData, user *gravitee.User) error {
+	d.Set("source", user.Source)
+	d.Set("source_id", user.SourceID)
+	d.Set("first_name", user.FirstName)
+	d.Set("last_name", user.LastName)
+	d.Set("email", user.Email)
+	d.Set("status", user.Status)
+
+	organizationRoles := make([]string, 0, len(user.Roles))
+	for _, role := range user.Roles {
+		if role.Scope == "" || role.Scope == "ORGANIZATION" {
+			organizationRoles = append(organizationRoles, role.Name)
+		}
+	}
+
+	if err := d.Set("organization_roles", organizationRoles); err != nil {
+		return err
+	}
+
+	environmentRoles := make([]string, 0, len(user.EnvRoles["DEFAULT"]))
+	for _, role := range user.EnvRoles["DEFAULT"] {
+		environmentRoles = append(environmentRoles, role.Name)
+	}
+
+	if err := d.Set("environment_roles", environmentRoles); err != nil {
+		return err
+	}
+
+	return nil
+}
\ No newline at end of file
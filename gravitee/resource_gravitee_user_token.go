@@ -0,0 +1,106 @@
+// resource_gravitee_user_token.go
+package gravitee
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGraviteeUserToken() *schema.Resource {
+	return &schema.Resource{
+		Description: "Personal access token of a user. Gravitee only returns the token value when it is created, so it is " +
+			"kept in the Terraform state: protect the state accordingly, as the provider cannot use ephemeral or write-only " +
+			"values for a secret generated by the server",
+		CreateContext: resourceGraviteeUserTokenCreate,
+		ReadContext:   resourceGraviteeUserTokenRead,
+		DeleteContext: resourceGraviteeUserTokenDelete,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the user owning the token",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the token",
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary value, changing it replaces the token",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Value of the personal access token, stored in the state. Gravitee only returns it when the token is created",
+			},
+			"created_at": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Creation timestamp of the token",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceGraviteeUserTokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+	userID := d.Get("user_id").(string)
+
+	createdToken, err := client.CreateUserToken(userID, &gravitee.UserToken{
+		Name: d.Get("name").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdToken.ID)
+	d.Set("token", createdToken.Token)
+
+	return resourceGraviteeUserTokenRead(ctx, d, m)
+}
+
+func resourceGraviteeUserTokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	tokens, err := client.ListUserTokens(d.Get("user_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The token value is not returned anymore, keep the one from the state
+	for _, token := range tokens {
+		if token.ID == d.Id() {
+			d.Set("name", token.Name)
+			d.Set("created_at", token.CreatedAt)
+			return nil
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceGraviteeUserTokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*gravitee.Client)
+
+	err := client.DeleteUserToken(d.Get("user_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
\ No newline at end of file
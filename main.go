@@ -36,12 +36,41 @@ func Provider() *schema.Provider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"gravitee_api":          resourceGraviteeAPI(),
-			"gravitee_plan":         resourceGraviteePlan(),
-			"gravitee_subscription": resourceGraviteeSubscription(),
+			"gravitee_api":                          resourceGraviteeAPI(),
+			"gravitee_api_deployment":               resourceGraviteeAPIDeployment(),
+			"gravitee_api_member":                   resourceGraviteeAPIMember(),
+			"gravitee_api_members":                  resourceGraviteeAPIMembers(),
+			"gravitee_api_page":                     resourceGraviteeAPIPage(),
+			"gravitee_api_pages_import":             resourceGraviteeAPIPagesImport(),
+			"gravitee_api_review":                   resourceGraviteeAPIReview(),
+			"gravitee_application":                  resourceGraviteeApplication(),
+			"gravitee_application_api_key":          resourceGraviteeApplicationAPIKey(),
+			"gravitee_application_member":           resourceGraviteeApplicationMember(),
+			"gravitee_application_members":          resourceGraviteeApplicationMembers(),
+			"gravitee_category":                     resourceGraviteeCategory(),
+			"gravitee_dictionary":                   resourceGraviteeDictionary(),
+			"gravitee_entrypoint_mapping":           resourceGraviteeEntrypointMapping(),
+			"gravitee_environment_settings":         resourceGraviteeEnvironmentSettings(),
+			"gravitee_group":                        resourceGraviteeGroup(),
+			"gravitee_group_member":                 resourceGraviteeGroupMember(),
+			"gravitee_identity_provider":            resourceGraviteeIdentityProvider(),
+			"gravitee_identity_provider_activation": resourceGraviteeIdentityProviderActivation(),
+			"gravitee_organization_settings":        resourceGraviteeOrganizationSettings(),
+			"gravitee_plan":                         resourceGraviteePlan(),
+			"gravitee_portal_navigation_item":       resourceGraviteePortalNavigationItem(),
+			"gravitee_portal_page":                  resourceGraviteePortalPage(),
+			"gravitee_portal_theme":                 resourceGraviteePortalTheme(),
+			"gravitee_role":                         resourceGraviteeRole(),
+			"gravitee_sharding_tag":                 resourceGraviteeShardingTag(),
+			"gravitee_subscription":                 resourceGraviteeSubscription(),
+			"gravitee_tenant":                       resourceGraviteeTenant(),
+			"gravitee_user":                         resourceGraviteeUser(),
+			"gravitee_user_token":                   resourceGraviteeUserToken(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"gravitee_api": dataSourceGraviteeAPI(),
+			"gravitee_api":   dataSourceGraviteeAPI(),
+			"gravitee_group": dataSourceGraviteeGroup(),
+			"gravitee_users": dataSourceGraviteeUsers(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}